@@ -2,8 +2,12 @@ package jikan
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"iter"
+	"maps"
 	"net/url"
+	"strconv"
 	"strings"
 )
 
@@ -48,14 +52,14 @@ type Anime struct {
 	TitleEN         string        `json:"title_english"`
 	TitleJP         string        `json:"title_japanese"`
 	TitleSynonyms   []string      `json:"title_synonyms"`
-	Type            *string       `json:"type"`
-	Source          *string       `json:"source"`
+	Type            *AnimeType    `json:"type"`
+	Source          *AnimeSource  `json:"source"`
 	Episodes        *int          `json:"episodes"`
-	Status          *string       `json:"status"`
+	Status          *AnimeStatus  `json:"status"`
 	Airing          bool          `json:"airing"`
 	Aired           AiredInfo     `json:"aired"`
 	Duration        *string       `json:"duration"`
-	Rating          *string       `json:"rating"`
+	Rating          *AnimeRating  `json:"rating"`
 	Score           *float64      `json:"score"`
 	ScoredBy        *int          `json:"scored_by"`
 	Rank            *int          `json:"rank"`
@@ -64,7 +68,7 @@ type Anime struct {
 	Favorites       *int          `json:"favorites"`
 	Synopsis        *string       `json:"synopsis"`
 	Background      *string       `json:"background"`
-	Season          *string       `json:"season"`
+	Season          *AnimeSeason  `json:"season"`
 	Year            *int          `json:"year"`
 	Broadcast       BroadcastInfo `json:"broadcast"`
 	Producers       []Entity      `json:"producers"`
@@ -77,16 +81,15 @@ type Anime struct {
 }
 
 // IsExplicit will check the rating to determine if the anime is considered explicit.
+//
+// This is true for R - 17+, R+ and Rx, use IsHentai to only match Rx.
 func (a *Anime) IsExplicit() bool {
-	isExplicit := false
-
-	if a.Rating != nil {
-		if strings.HasPrefix(*a.Rating, "R") {
-			isExplicit = true
-		}
-	}
+	return a.Rating != nil && a.Rating.IsExplicit()
+}
 
-	return isExplicit
+// IsHentai will check if the anime is rated Rx.
+func (a *Anime) IsHentai() bool {
+	return a.Rating != nil && a.Rating.IsHentai()
 }
 
 type AnimeFull struct {
@@ -98,20 +101,72 @@ type AnimeFull struct {
 	Streaming []Link     `json:"streaming"`
 }
 
+// AnimeID is the MyAnimeList ID of an anime.
+type AnimeID int
+
+func (id AnimeID) String() string {
+	return strconv.Itoa(int(id))
+}
+
+// ParseAnimeID will parse an ID given as a string, surrounding whitespace is
+// ignored. Errors match ErrInvalidQuery.
+func ParseAnimeID(s string) (AnimeID, error) {
+	trimmed := strings.TrimSpace(s)
+
+	// strconv.Atoi accepts a leading sign, IDs are only ever digits.
+	if trimmed == "" || strings.TrimLeft(trimmed, "0123456789") != "" {
+		return 0, invalidQuery("id", "%q is not a valid anime id", s)
+	}
+
+	id, err := strconv.Atoi(trimmed)
+	if err != nil || id <= 0 {
+		return 0, invalidQuery("id", "%q is not a valid anime id", s)
+	}
+
+	return AnimeID(id), nil
+}
+
 // GetFullById returns a complete anime resource.
 //
+// The id is validated with ParseAnimeID, use GetFullByMalID for integer IDs.
+//
 // https://docs.api.jikan.moe/#/anime/getanimefullbyid
 func (s *AnimeEndpoints) GetFullById(ctx context.Context, id string) (*AnimeFull, *Response, error) {
-	path := "/v4/anime/" + id + "/full"
+	animeID, err := ParseAnimeID(id)
+	if err != nil {
+		return nil, nil, err
+	}
 
-	if s.client.cache != nil {
-		info, err := s.client.cache.Anime().GetAnimeFull(ctx, id)
+	return s.GetFullByMalID(ctx, animeID)
+}
+
+// GetFullByMalID returns a complete anime resource.
+//
+// https://docs.api.jikan.moe/#/anime/getanimefullbyid
+func (s *AnimeEndpoints) GetFullByMalID(ctx context.Context, id AnimeID) (*AnimeFull, *Response, error) {
+	ctx = withOperation(ctx, OperationAnimeGetFullById)
+
+	path := "/v4/anime/" + id.String() + "/full"
+
+	if s.client.readCache(ctx) {
+		info, err := s.client.cache.Anime().GetAnimeFullByMalID(ctx, id)
 		if err == nil {
+			s.client.observeCacheHit(ctx, path)
+
 			return info, &Response{
 				IsCached: true,
 				Response: nil,
 			}, nil
 		}
+
+		if errors.Is(err, ErrNotFound) {
+			s.client.observeCacheHit(ctx, path)
+
+			return nil, &Response{
+				IsCached: true,
+				Response: nil,
+			}, err
+		}
 	}
 
 	req, err := s.client.NewGETRequest(path)
@@ -122,6 +177,16 @@ func (s *AnimeEndpoints) GetFullById(ctx context.Context, id string) (*AnimeFull
 	info := new(ResponseBody[AnimeFull])
 	resp, err := s.client.Do(ctx, req, info)
 	if err != nil {
+		if errors.Is(err, ErrNotFound) {
+			err = &NotFoundError{Resource: "anime", ID: id.String(), Err: err}
+
+			if s.client.cache != nil {
+				go func() {
+					s.client.logCacheWrite(ctx, "SetAnimeNotFound", s.client.cache.Anime().SetAnimeNotFoundByMalID(ctx, id))
+				}()
+			}
+		}
+
 		return nil, &Response{
 			IsCached: false,
 			Response: resp,
@@ -130,7 +195,7 @@ func (s *AnimeEndpoints) GetFullById(ctx context.Context, id string) (*AnimeFull
 
 	if s.client.cache != nil {
 		go func() {
-			_ = s.client.cache.Anime().SetAnimeFull(ctx, info.Data)
+			s.client.logCacheWrite(ctx, "SetAnimeFull", s.client.cache.Anime().SetAnimeFull(ctx, info.Data))
 		}()
 	}
 
@@ -142,18 +207,45 @@ func (s *AnimeEndpoints) GetFullById(ctx context.Context, id string) (*AnimeFull
 
 // GetById returns an anime resource.
 //
+// The id is validated with ParseAnimeID, use GetByMalID for integer IDs.
+//
 // https://docs.api.jikan.moe/#/anime/getanimebyid
 func (s *AnimeEndpoints) GetById(ctx context.Context, id string) (*Anime, *Response, error) {
-	path := "/v4/anime/" + id
+	animeID, err := ParseAnimeID(id)
+	if err != nil {
+		return nil, nil, err
+	}
 
-	if s.client.cache != nil {
-		info, err := s.client.cache.Anime().GetAnime(ctx, id)
+	return s.GetByMalID(ctx, animeID)
+}
+
+// GetByMalID returns an anime resource.
+//
+// https://docs.api.jikan.moe/#/anime/getanimebyid
+func (s *AnimeEndpoints) GetByMalID(ctx context.Context, id AnimeID) (*Anime, *Response, error) {
+	ctx = withOperation(ctx, OperationAnimeGetById)
+
+	path := "/v4/anime/" + id.String()
+
+	if s.client.readCache(ctx) {
+		info, err := s.client.cache.Anime().GetAnimeByMalID(ctx, id)
 		if err == nil {
+			s.client.observeCacheHit(ctx, path)
+
 			return info, &Response{
 				IsCached: true,
 				Response: nil,
 			}, nil
 		}
+
+		if errors.Is(err, ErrNotFound) {
+			s.client.observeCacheHit(ctx, path)
+
+			return nil, &Response{
+				IsCached: true,
+				Response: nil,
+			}, err
+		}
 	}
 
 	req, err := s.client.NewGETRequest(path)
@@ -164,6 +256,16 @@ func (s *AnimeEndpoints) GetById(ctx context.Context, id string) (*Anime, *Respo
 	info := new(ResponseBody[Anime])
 	resp, err := s.client.Do(ctx, req, info)
 	if err != nil {
+		if errors.Is(err, ErrNotFound) {
+			err = &NotFoundError{Resource: "anime", ID: id.String(), Err: err}
+
+			if s.client.cache != nil {
+				go func() {
+					s.client.logCacheWrite(ctx, "SetAnimeNotFound", s.client.cache.Anime().SetAnimeNotFoundByMalID(ctx, id))
+				}()
+			}
+		}
+
 		return nil, &Response{
 			IsCached: false,
 			Response: resp,
@@ -172,7 +274,7 @@ func (s *AnimeEndpoints) GetById(ctx context.Context, id string) (*Anime, *Respo
 
 	if s.client.cache != nil {
 		go func() {
-			_ = s.client.cache.Anime().SetAnime(ctx, info.Data)
+			s.client.logCacheWrite(ctx, "SetAnime", s.client.cache.Anime().SetAnime(ctx, info.Data))
 		}()
 	}
 
@@ -189,6 +291,7 @@ type Episode struct {
 	TitleJapanese *string  `json:"title_japanese"`
 	TitleRomanji  *string  `json:"title_romanji"`
 	Aired         *string  `json:"aired"`
+	Duration      *int     `json:"duration"`
 	Score         *float32 `json:"score"`
 	Filler        bool     `json:"filler"`
 	Recap         bool     `json:"recap"`
@@ -197,18 +300,44 @@ type Episode struct {
 
 // GetEpisodes will return a list of episodes for an anime.
 //
+// The id is validated with ParseAnimeID, use GetEpisodesByMalID for integer IDs.
+//
 // https://docs.api.jikan.moe/#/anime/getanimeepisodes
 func (s *AnimeEndpoints) GetEpisodes(ctx context.Context, id string, query *url.Values) (*PaginatedResponseBody[Episode], *Response, error) {
-	episodes := new(PaginatedResponseBody[Episode])
+	animeID, err := ParseAnimeID(id)
+	if err != nil {
+		return nil, nil, err
+	}
 
-	path := fmt.Sprintf("/v4/anime/%s/episodes", id)
-	path += "?" + query.Encode()
+	return s.GetEpisodesByMalID(ctx, animeID, query)
+}
+
+// GetEpisodesByMalID will return a list of episodes for an anime.
+//
+// https://docs.api.jikan.moe/#/anime/getanimeepisodes
+func (s *AnimeEndpoints) GetEpisodesByMalID(ctx context.Context, id AnimeID, query *url.Values) (*PaginatedResponseBody[Episode], *Response, error) {
+	ctx = withOperation(ctx, OperationAnimeGetEpisodes)
+
+	path := withQuery(fmt.Sprintf("/v4/anime/%d/episodes", id), query)
+
+	if s.client.readCache(ctx) {
+		episodes, err := s.client.cache.Lists().GetEpisodeList(ctx, path)
+		if err == nil {
+			s.client.observeCacheHit(ctx, path)
+
+			return episodes, &Response{
+				IsCached: true,
+				Response: nil,
+			}, nil
+		}
+	}
 
 	req, err := s.client.NewGETRequest(path)
 	if err != nil {
 		return nil, nil, err
 	}
 
+	episodes := new(PaginatedResponseBody[Episode])
 	resp, err := s.client.Do(ctx, req, episodes)
 	if err != nil {
 		return nil, &Response{
@@ -219,7 +348,8 @@ func (s *AnimeEndpoints) GetEpisodes(ctx context.Context, id string, query *url.
 
 	if s.client.cache != nil {
 		go func() {
-			_ = s.client.cache.Anime().BulkSetEpisodes(ctx, id, episodes.Data)
+			s.client.logCacheWrite(ctx, "BulkSetEpisodes", s.client.cache.Anime().BulkSetEpisodesByMalID(ctx, id, episodes.Data))
+			s.client.logCacheWrite(ctx, "SetEpisodeList", s.client.cache.Lists().SetEpisodeList(ctx, path, *episodes, episodeListTTL))
 		}()
 	}
 
@@ -231,18 +361,49 @@ func (s *AnimeEndpoints) GetEpisodes(ctx context.Context, id string, query *url.
 
 // GetEpisodeById will return the details for a specific episodes based on its id.
 //
+// The id is validated with ParseAnimeID, use GetEpisodeByMalID for integer IDs.
+//
 // https://docs.api.jikan.moe/#/anime/getanimeepisodebyid
 func (s *AnimeEndpoints) GetEpisodeById(ctx context.Context, id string, ep int) (*Episode, *Response, error) {
-	path := fmt.Sprintf("/v4/anime/%s/episodes/%d", id, ep)
+	animeID, err := ParseAnimeID(id)
+	if err != nil {
+		return nil, nil, err
+	}
 
-	if s.client.cache != nil {
-		episode, err := s.client.cache.Anime().GetEpisode(ctx, id, ep)
+	return s.GetEpisodeByMalID(ctx, animeID, ep)
+}
+
+// GetEpisodeByMalID will return the details for a specific episodes based on its id.
+//
+// https://docs.api.jikan.moe/#/anime/getanimeepisodebyid
+func (s *AnimeEndpoints) GetEpisodeByMalID(ctx context.Context, id AnimeID, ep int) (*Episode, *Response, error) {
+	ctx = withOperation(ctx, OperationAnimeGetEpisodeById)
+
+	if ep <= 0 {
+		return nil, nil, invalidQuery("episode", "%d is not a valid episode number", ep)
+	}
+
+	path := fmt.Sprintf("/v4/anime/%d/episodes/%d", id, ep)
+
+	if s.client.readCache(ctx) {
+		episode, err := s.client.cache.Anime().GetEpisodeByMalID(ctx, id, ep)
 		if err == nil {
+			s.client.observeCacheHit(ctx, path)
+
 			return episode, &Response{
 				IsCached: true,
 				Response: nil,
 			}, nil
 		}
+
+		if errors.Is(err, ErrNotFound) {
+			s.client.observeCacheHit(ctx, path)
+
+			return nil, &Response{
+				IsCached: true,
+				Response: nil,
+			}, err
+		}
 	}
 
 	req, err := s.client.NewGETRequest(path)
@@ -253,6 +414,16 @@ func (s *AnimeEndpoints) GetEpisodeById(ctx context.Context, id string, ep int)
 	episode := new(ResponseBody[Episode])
 	resp, err := s.client.Do(ctx, req, episode)
 	if err != nil {
+		if errors.Is(err, ErrNotFound) {
+			err = &NotFoundError{Resource: "episode", ID: fmt.Sprintf("%d/%d", id, ep), Err: err}
+
+			if s.client.cache != nil {
+				go func() {
+					s.client.logCacheWrite(ctx, "SetEpisodeNotFound", s.client.cache.Anime().SetEpisodeNotFoundByMalID(ctx, id, ep))
+				}()
+			}
+		}
+
 		return nil, &Response{
 			IsCached: false,
 			Response: resp,
@@ -261,7 +432,7 @@ func (s *AnimeEndpoints) GetEpisodeById(ctx context.Context, id string, ep int)
 
 	if s.client.cache != nil {
 		go func() {
-			_ = s.client.cache.Anime().SetEpisode(ctx, id, episode.Data)
+			s.client.logCacheWrite(ctx, "SetEpisode", s.client.cache.Anime().SetEpisodeByMalID(ctx, id, episode.Data))
 		}()
 	}
 
@@ -275,20 +446,34 @@ func (s *AnimeEndpoints) GetEpisodeById(ctx context.Context, id string, ep int)
 //
 // https://docs.api.jikan.moe/#/anime/getanimesearch
 func (s *AnimeEndpoints) GetSearch(ctx context.Context, query string, values *url.Values) (*PaginatedResponseBody[Anime], *Response, error) {
-	info := new(PaginatedResponseBody[Anime])
-	path := "/v4/anime"
-	if values == nil {
-		values = &url.Values{}
+	ctx = withOperation(ctx, OperationAnimeGetSearch)
+
+	params := url.Values{}
+	if values != nil {
+		params = maps.Clone(*values)
 	}
 
-	values.Set("q", query)
-	path += "?" + values.Encode()
+	params.Set("q", query)
+	path := withQuery("/v4/anime", &params)
+
+	if s.client.readCache(ctx) {
+		info, err := s.client.cache.Lists().GetAnimeList(ctx, path)
+		if err == nil {
+			s.client.observeCacheHit(ctx, path)
+
+			return info, &Response{
+				IsCached: true,
+				Response: nil,
+			}, nil
+		}
+	}
 
 	req, err := s.client.NewGETRequest(path)
 	if err != nil {
 		return nil, nil, err
 	}
 
+	info := new(PaginatedResponseBody[Anime])
 	resp, err := s.client.Do(ctx, req, info)
 	if err != nil {
 		return nil, &Response{
@@ -299,7 +484,8 @@ func (s *AnimeEndpoints) GetSearch(ctx context.Context, query string, values *ur
 
 	if s.client.cache != nil {
 		go func() {
-			_ = s.client.cache.Anime().BulkSetAnime(ctx, info.Data)
+			s.client.logCacheWrite(ctx, "BulkSetAnime", s.client.cache.Anime().BulkSetAnime(ctx, info.Data))
+			s.client.logCacheWrite(ctx, "SetAnimeList", s.client.cache.Lists().SetAnimeList(ctx, path, *info, searchListTTL))
 		}()
 	}
 
@@ -308,3 +494,36 @@ func (s *AnimeEndpoints) GetSearch(ctx context.Context, query string, values *ur
 		Response: resp,
 	}, nil
 }
+
+// SearchAll will iterate over every anime matching a query, requesting pages as needed.
+// Iteration stops after maxItems anime when it is greater than zero.
+//
+// https://docs.api.jikan.moe/#/anime/getanimesearch
+func (s *AnimeEndpoints) SearchAll(ctx context.Context, query string, values *url.Values, maxItems int) iter.Seq2[Anime, error] {
+	return paginate(ctx, values, maxItems, func(values *url.Values) (*PaginatedResponseBody[Anime], error) {
+		info, _, err := s.GetSearch(ctx, query, values)
+		return info, err
+	})
+}
+
+// AllEpisodes will iterate over every episode of an anime, requesting pages as needed.
+// Iteration stops after maxItems episodes when it is greater than zero.
+//
+// https://docs.api.jikan.moe/#/anime/getanimeepisodes
+func (s *AnimeEndpoints) AllEpisodes(ctx context.Context, id AnimeID, query *url.Values, maxItems int) iter.Seq2[Episode, error] {
+	return paginate(ctx, query, maxItems, func(query *url.Values) (*PaginatedResponseBody[Episode], error) {
+		episodes, _, err := s.GetEpisodesByMalID(ctx, id, query)
+		return episodes, err
+	})
+}
+
+// FetchAllEpisodes will fetch every page of episodes for an anime, fetching pages
+// after the first concurrently.
+//
+// https://docs.api.jikan.moe/#/anime/getanimeepisodes
+func (s *AnimeEndpoints) FetchAllEpisodes(ctx context.Context, id AnimeID, query *url.Values, opts *FetchAllOptions) ([]Episode, error) {
+	return fetchAll(ctx, query, opts, episodeKey, func(ctx context.Context, query *url.Values) (*PaginatedResponseBody[Episode], error) {
+		episodes, _, err := s.GetEpisodesByMalID(ctx, id, query)
+		return episodes, err
+	})
+}
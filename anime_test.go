@@ -1,6 +1,7 @@
 package jikan
 
 import (
+	"errors"
 	"net/http"
 	"testing"
 )
@@ -30,3 +31,18 @@ func TestGetAnimeById(t *testing.T) {
 		t.Fatal(resp.Response.Status)
 	}
 }
+
+func TestParseAnimeID(t *testing.T) {
+	for input, expected := range map[string]AnimeID{"1": 1, " 52991 ": 52991} {
+		id, err := ParseAnimeID(input)
+		if err != nil || id != expected {
+			t.Fatalf("ParseAnimeID(%q) = %d, %v", input, id, err)
+		}
+	}
+
+	for _, input := range []string{"", "1?foo", "-1", "+1", "0", "1/full"} {
+		if _, err := ParseAnimeID(input); !errors.Is(err, ErrInvalidQuery) {
+			t.Fatalf("ParseAnimeID(%q): expected ErrInvalidQuery, got %v", input, err)
+		}
+	}
+}
@@ -0,0 +1,165 @@
+package jikan
+
+import (
+	"context"
+	"errors"
+
+	"golang.org/x/sync/errgroup"
+)
+
+// GetManyOptions configure how the GetMany endpoints fetch anime missing from the cache.
+type GetManyOptions struct {
+	// Workers is the number of anime fetched at once, defaults to 3. Every worker
+	// shares the client's rate limit, so more workers only help while it has budget.
+	Workers int
+}
+
+// BatchResult is the result for a single ID of a batch.
+type BatchResult[T any] struct {
+	// ID is zero when the ID given to a string variant was invalid.
+	ID       AnimeID
+	Data     *T
+	Response *Response
+	Err      error
+}
+
+// GetManyById returns anime resources for many IDs, see GetManyByMalID.
+//
+// Every id is validated with ParseAnimeID, invalid IDs only fail their own result.
+func (s *AnimeEndpoints) GetManyById(ctx context.Context, ids []string, opts *GetManyOptions) []BatchResult[Anime] {
+	return getManyByString(ctx, ids, opts, s.GetManyByMalID)
+}
+
+// GetManyByMalID returns anime resources for many IDs.
+//
+// Cached anime are returned without waiting on requests, the rest are fetched
+// concurrently under the client's rate limit. Results are in the order of ids
+// and errors are reported per ID, so a failed anime never fails the batch.
+// Repeated IDs are only fetched once.
+func (s *AnimeEndpoints) GetManyByMalID(ctx context.Context, ids []AnimeID, opts *GetManyOptions) []BatchResult[Anime] {
+	return getMany(ctx, s.client, ids, opts, AnimeCache.GetAnimeByMalID, s.GetByMalID)
+}
+
+// GetManyFullById returns complete anime resources for many IDs, see GetManyByMalID.
+//
+// Every id is validated with ParseAnimeID, invalid IDs only fail their own result.
+func (s *AnimeEndpoints) GetManyFullById(ctx context.Context, ids []string, opts *GetManyOptions) []BatchResult[AnimeFull] {
+	return getManyByString(ctx, ids, opts, s.GetManyFullByMalID)
+}
+
+// GetManyFullByMalID returns complete anime resources for many IDs, see GetManyByMalID.
+func (s *AnimeEndpoints) GetManyFullByMalID(ctx context.Context, ids []AnimeID, opts *GetManyOptions) []BatchResult[AnimeFull] {
+	return getMany(ctx, s.client, ids, opts, AnimeCache.GetAnimeFullByMalID, s.GetFullByMalID)
+}
+
+// getManyByString will parse ids, passing the valid ones on to getMany.
+func getManyByString[T any](
+	ctx context.Context,
+	ids []string,
+	opts *GetManyOptions,
+	getMany func(ctx context.Context, ids []AnimeID, opts *GetManyOptions) []BatchResult[T],
+) []BatchResult[T] {
+	results := make([]BatchResult[T], len(ids))
+
+	valid := make([]AnimeID, 0, len(ids))
+	indexes := make([]int, 0, len(ids))
+	for i, id := range ids {
+		animeID, err := ParseAnimeID(id)
+		if err != nil {
+			results[i].Err = err
+			continue
+		}
+
+		valid = append(valid, animeID)
+		indexes = append(indexes, i)
+	}
+
+	for i, result := range getMany(ctx, valid, opts) {
+		results[indexes[i]] = result
+	}
+
+	return results
+}
+
+// getMany will serve ids from the cache, then fetch the misses with a bounded
+// number of workers. IDs not yet fetched when ctx is done fail with its error.
+func getMany[T any](
+	ctx context.Context,
+	client *Client,
+	ids []AnimeID,
+	opts *GetManyOptions,
+	cached func(cache AnimeCache, ctx context.Context, id AnimeID) (*T, error),
+	fetch func(ctx context.Context, id AnimeID) (*T, *Response, error),
+) []BatchResult[T] {
+	workers := defaultFetchWorkers
+	if opts != nil && opts.Workers > 0 {
+		workers = opts.Workers
+	}
+
+	results := make([]BatchResult[T], len(ids))
+
+	// misses maps every ID that needs fetching to its positions in ids.
+	misses := make(map[AnimeID][]int)
+	order := make([]AnimeID, 0, len(ids))
+
+	for i, id := range ids {
+		results[i].ID = id
+
+		if client.readCache(ctx) {
+			info, err := cached(client.cache.Anime(), ctx, id)
+			if err == nil {
+				results[i].Data = info
+				results[i].Response = &Response{IsCached: true}
+				continue
+			}
+
+			if errors.Is(err, ErrNotFound) {
+				results[i].Response = &Response{IsCached: true}
+				results[i].Err = err
+				continue
+			}
+		}
+
+		if _, ok := misses[id]; !ok {
+			order = append(order, id)
+		}
+
+		misses[id] = append(misses[id], i)
+	}
+
+	var g errgroup.Group
+	g.SetLimit(workers)
+
+	for _, id := range order {
+		g.Go(func() error {
+			var (
+				info *T
+				resp *Response
+			)
+
+			// The pre-pass already read the cache, so fetching skips it.
+			err := ctx.Err()
+			if err == nil {
+				info, resp, err = fetch(ForceRefresh(ctx), id)
+			}
+
+			// Every position of a repeated ID gets its own copy of the data.
+			for n, i := range misses[id] {
+				data := info
+				if info != nil && n > 0 {
+					data = new(*info)
+				}
+
+				results[i].Data = data
+				results[i].Response = resp
+				results[i].Err = err
+			}
+
+			return nil
+		})
+	}
+
+	_ = g.Wait()
+
+	return results
+}
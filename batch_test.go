@@ -0,0 +1,92 @@
+package jikan
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"sync/atomic"
+	"testing"
+)
+
+func TestGetManyById(t *testing.T) {
+	var requests atomic.Int32
+	client := newTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests.Add(1)
+
+		if r.URL.Path != "/v4/anime/2" {
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = w.Write([]byte(`{"status":404,"message":"Resource does not exist"}`))
+			return
+		}
+
+		_ = json.NewEncoder(w).Encode(ResponseBody[Anime]{Data: Anime{MalID: 2}})
+	}))
+
+	if err := client.cache.Anime().SetAnime(t.Context(), Anime{MalID: 1}); err != nil {
+		t.Fatal(err)
+	}
+
+	results := client.Anime.GetManyById(t.Context(), []string{"1", "x", "2", "3", "2"}, nil)
+	if len(results) != 5 {
+		t.Fatalf("expected 5 results, got %d", len(results))
+	}
+
+	if results[0].Err != nil || !results[0].Response.IsCached || results[0].Data.MalID != 1 {
+		t.Fatalf("expected cached anime 1, got %+v", results[0])
+	}
+
+	if !errors.Is(results[1].Err, ErrInvalidQuery) {
+		t.Fatalf("expected ErrInvalidQuery, got %v", results[1].Err)
+	}
+
+	for _, i := range []int{2, 4} {
+		if results[i].Err != nil || results[i].ID != 2 || results[i].Data.MalID != 2 {
+			t.Fatalf("expected anime 2 at %d, got %+v", i, results[i])
+		}
+	}
+
+	if results[2].Data == results[4].Data {
+		t.Fatal("expected repeated IDs to get their own copy")
+	}
+
+	if !errors.Is(results[3].Err, ErrNotFound) {
+		t.Fatalf("expected ErrNotFound, got %v", results[3].Err)
+	}
+
+	if n := requests.Load(); n != 2 {
+		t.Fatalf("expected 2 requests, got %d", n)
+	}
+
+	// Misses are fetched without reading the cache a second time.
+	if stats := client.cache.Stats()["anime"]; stats.Hits != 1 || stats.Misses != 3 {
+		t.Fatalf("expected 1 hit and 3 misses, got %+v", stats)
+	}
+}
+
+func TestGetManyWithoutCache(t *testing.T) {
+	var requests atomic.Int32
+	client := newTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests.Add(1)
+		_ = json.NewEncoder(w).Encode(ResponseBody[Anime]{Data: Anime{MalID: 1}})
+	}), WithCache(nil))
+
+	results := client.Anime.GetManyByMalID(t.Context(), []AnimeID{1}, nil)
+	if results[0].Err != nil || results[0].Data.MalID != 1 {
+		t.Fatalf("expected anime 1, got %+v", results[0])
+	}
+
+	ctx, cancel := context.WithCancel(t.Context())
+	cancel()
+
+	results = client.Anime.GetManyByMalID(ctx, []AnimeID{2, 3}, nil)
+	for _, result := range results {
+		if !errors.Is(result.Err, context.Canceled) {
+			t.Fatalf("expected context.Canceled, got %+v", result)
+		}
+	}
+
+	if requests.Load() != 1 {
+		t.Fatalf("expected no requests after cancellation, got %d", requests.Load()-1)
+	}
+}
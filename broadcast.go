@@ -0,0 +1,203 @@
+package jikan
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+const (
+	// defaultBroadcastTimezone is used when Jikan does not send a timezone, MAL
+	// lists broadcast times in JST.
+	defaultBroadcastTimezone = "Asia/Tokyo"
+
+	week = time.Hour * 24 * 7
+)
+
+// jst is used when the system has no timezone database, Japan does not observe
+// daylight saving time so a fixed offset is exact.
+var jst = time.FixedZone("JST", 9*60*60)
+
+// Location will resolve Timezone, defaulting to JST. It returns false for
+// timezones unknown to the system.
+func (b BroadcastInfo) Location() (*time.Location, bool) {
+	name := b.Timezone
+	if name == "" {
+		name = defaultBroadcastTimezone
+	}
+
+	loc, err := time.LoadLocation(name)
+	if err != nil {
+		if name == defaultBroadcastTimezone {
+			return jst, true
+		}
+
+		return nil, false
+	}
+
+	return loc, true
+}
+
+// Weekday will parse Day, e.g. "Saturdays". It returns false for "Unknown" or
+// empty values.
+func (b BroadcastInfo) Weekday() (time.Weekday, bool) {
+	day := strings.TrimSuffix(strings.ToLower(strings.TrimSpace(b.Day)), "s")
+
+	for weekday := time.Sunday; weekday <= time.Saturday; weekday++ {
+		if strings.ToLower(weekday.String()) == day {
+			return weekday, true
+		}
+	}
+
+	return 0, false
+}
+
+// Clock will parse Time, e.g. "01:30". Hours past 23 are allowed, as used for
+// late-night broadcasts, and roll over to the next day.
+func (b BroadcastInfo) Clock() (hour int, minute int, ok bool) {
+	if _, err := fmt.Sscanf(strings.TrimSpace(b.Time), "%d:%d", &hour, &minute); err != nil {
+		return 0, 0, false
+	}
+
+	if hour < 0 || hour > 29 || minute < 0 || minute > 59 {
+		return 0, 0, false
+	}
+
+	return hour, minute, true
+}
+
+// BroadcastSchedule is the weekly broadcast slot of an anime.
+type BroadcastSchedule struct {
+	Location *time.Location
+	Weekday  time.Weekday
+	Hour     int
+	Minute   int
+
+	// First is the first broadcast, when the premiere date is only known to the
+	// month or year it is the first slot of that period. It is zero when the
+	// premiere date is unknown.
+	First time.Time
+	// Last is the final broadcast, it is zero when neither the episode count nor
+	// the end date is known.
+	Last time.Time
+}
+
+// next will return the first slot at or after t, ignoring First and Last.
+func (s BroadcastSchedule) next(t time.Time) time.Time {
+	local := t.In(s.Location)
+
+	// Start a day early so slots past midnight, e.g. "25:00", are not skipped.
+	for i := -1; i <= 7; i++ {
+		day := local.AddDate(0, 0, i)
+		if day.Weekday() != s.Weekday {
+			continue
+		}
+
+		slot := time.Date(day.Year(), day.Month(), day.Day(), s.Hour, s.Minute, 0, 0, s.Location)
+		if !slot.Before(t) {
+			return slot
+		}
+	}
+
+	// Unreachable, a matching weekday always occurs within the window.
+	return time.Time{}
+}
+
+// Next will return up to n broadcasts at or after now, bounded by First and Last.
+func (s BroadcastSchedule) Next(now time.Time, n int) []time.Time {
+	if !s.First.IsZero() && now.Before(s.First) {
+		now = s.First
+	}
+
+	var times []time.Time
+	for slot := s.next(now); len(times) < n; slot = s.next(slot.Add(week - time.Hour)) {
+		if !s.Last.IsZero() && slot.After(s.Last) {
+			break
+		}
+
+		times = append(times, slot)
+	}
+
+	return times
+}
+
+// BroadcastSchedule will build the weekly broadcast slot from Broadcast, bounded
+// by the aired range and episode count. It returns false when the day, time or
+// timezone is unknown, or when the anime has not aired and has no premiere date.
+func (a *Anime) BroadcastSchedule() (BroadcastSchedule, bool) {
+	start, hasStart := a.StartDate()
+	if !hasStart && a.Status != nil && *a.Status == AnimeStatusUpcoming {
+		return BroadcastSchedule{}, false
+	}
+
+	loc, ok := a.Broadcast.Location()
+	if !ok {
+		return BroadcastSchedule{}, false
+	}
+
+	weekday, ok := a.Broadcast.Weekday()
+	if !ok {
+		return BroadcastSchedule{}, false
+	}
+
+	hour, minute, ok := a.Broadcast.Clock()
+	if !ok {
+		return BroadcastSchedule{}, false
+	}
+
+	schedule := BroadcastSchedule{
+		Location: loc,
+		Weekday:  weekday,
+		Hour:     hour,
+		Minute:   minute,
+	}
+
+	// Aired dates are calendar dates in the broadcast timezone. Partial dates
+	// start at the beginning of their period, so no slot precedes the premiere.
+	if hasStart {
+		y, m, d := start.Time.Date()
+		schedule.First = schedule.next(time.Date(y, m, d, 0, 0, 0, 0, loc))
+	}
+
+	// The finale can only be counted from an exact premiere.
+	if hasStart && start.Precision == DatePrecisionDay && a.Episodes != nil && *a.Episodes > 0 {
+		schedule.Last = schedule.First.AddDate(0, 0, 7*(*a.Episodes-1))
+	}
+
+	if end, ok := a.EndDate(); ok && end.Precision == DatePrecisionDay {
+		y, m, d := end.Time.Date()
+
+		// The final broadcast is the last slot on or before the end date.
+		last := schedule.next(time.Date(y, m, d, 0, 0, 0, 0, loc).Add(-week + time.Hour*24))
+		if schedule.Last.IsZero() || last.Before(schedule.Last) {
+			schedule.Last = last
+		}
+	}
+
+	return schedule, true
+}
+
+// NextBroadcast will return the next broadcast at or after now. It returns false
+// when the schedule is unknown or the anime has finished airing.
+func (a *Anime) NextBroadcast(now time.Time) (time.Time, bool) {
+	times := a.NextBroadcasts(now, 1)
+	if len(times) == 0 {
+		return time.Time{}, false
+	}
+
+	return times[0], true
+}
+
+// NextBroadcasts will return up to n broadcasts at or after now, stopping after the finale.
+func (a *Anime) NextBroadcasts(now time.Time, n int) []time.Time {
+	if a.Status != nil && *a.Status == AnimeStatusFinished {
+		return nil
+	}
+
+	schedule, ok := a.BroadcastSchedule()
+	if !ok {
+		return nil
+	}
+
+	return schedule.Next(now, n)
+}
@@ -0,0 +1,94 @@
+package jikan
+
+import (
+	"testing"
+	"time"
+)
+
+func TestNextBroadcast(t *testing.T) {
+	anime := Anime{
+		Status:   new(AnimeStatusAiring),
+		Episodes: new(28),
+		Aired:    AiredInfo{From: "2023-09-29T00:00:00+00:00"},
+		Broadcast: BroadcastInfo{
+			Day:      "Fridays",
+			Time:     "23:00",
+			Timezone: "Asia/Tokyo",
+		},
+	}
+
+	before := time.Date(2023, time.September, 1, 0, 0, 0, 0, time.UTC)
+
+	times := anime.NextBroadcasts(before, 100)
+	if len(times) != 28 {
+		t.Fatalf("expected 28 broadcasts, got %d", len(times))
+	}
+
+	// 23:00 JST is 14:00 UTC.
+	if first := times[0].UTC(); !first.Equal(time.Date(2023, time.September, 29, 14, 0, 0, 0, time.UTC)) {
+		t.Fatalf("unexpected first broadcast: %s", first)
+	}
+
+	if last := times[27].UTC(); !last.Equal(time.Date(2024, time.April, 5, 14, 0, 0, 0, time.UTC)) {
+		t.Fatalf("unexpected last broadcast: %s", last)
+	}
+
+	next, ok := anime.NextBroadcast(time.Date(2023, time.October, 6, 14, 0, 1, 0, time.UTC))
+	if !ok || !next.Equal(time.Date(2023, time.October, 13, 14, 0, 0, 0, time.UTC)) {
+		t.Fatalf("unexpected next broadcast: %s", next)
+	}
+
+	if _, ok := anime.NextBroadcast(time.Date(2024, time.April, 6, 0, 0, 0, 0, time.UTC)); ok {
+		t.Fatal("expected no broadcast after the finale")
+	}
+
+	anime.Broadcast.Day = "Unknown"
+	if _, ok := anime.NextBroadcast(before); ok {
+		t.Fatal("expected no broadcast for an unknown day")
+	}
+}
+
+func TestNextBroadcastPartialPremiere(t *testing.T) {
+	anime := Anime{
+		Status:   new(AnimeStatusUpcoming),
+		Episodes: new(12),
+		Aired: AiredInfo{
+			From: "2025-04-01T00:00:00+00:00",
+			Prop: AiredProp{From: DateProp{Month: new(4), Year: new(2025)}},
+		},
+		Broadcast: BroadcastInfo{
+			Day:      "Saturdays",
+			Time:     "01:30",
+			Timezone: "Asia/Tokyo",
+		},
+	}
+
+	times := anime.NextBroadcasts(time.Date(2025, time.March, 1, 0, 0, 0, 0, time.UTC), 20)
+	if len(times) != 20 {
+		t.Fatalf("expected 20 broadcasts, got %d", len(times))
+	}
+
+	// The first Saturday of April 2025 is the 5th, 01:30 JST is 16:30 UTC the day before.
+	if first := times[0].UTC(); !first.Equal(time.Date(2025, time.April, 4, 16, 30, 0, 0, time.UTC)) {
+		t.Fatalf("expected no broadcast before the premiere month, got %s", first)
+	}
+}
+
+func TestNextBroadcastUnknownPremiere(t *testing.T) {
+	anime := Anime{
+		Status: new(AnimeStatusUpcoming),
+		Broadcast: BroadcastInfo{
+			Day:      "Saturdays",
+			Time:     "01:30",
+			Timezone: "Asia/Tokyo",
+		},
+	}
+
+	if _, ok := anime.BroadcastSchedule(); ok {
+		t.Fatal("expected no schedule without a premiere date")
+	}
+
+	if times := anime.NextBroadcasts(time.Date(2025, time.March, 1, 0, 0, 0, 0, time.UTC), 3); len(times) != 0 {
+		t.Fatalf("expected no broadcasts, got %v", times)
+	}
+}
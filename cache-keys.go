@@ -0,0 +1,175 @@
+package jikan
+
+import (
+	"fmt"
+	"hash/fnv"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/minnasync/jikan-go/internal/redisx"
+)
+
+const (
+	// defaultKeyPrefix is the namespace every cache key is written under.
+	defaultKeyPrefix = "jikan"
+)
+
+// WithKeyPrefix will namespace every cache key under prefix instead of "jikan".
+//
+// Use a different prefix per environment when several share one Redis instance.
+// An empty prefix falls back to "jikan".
+func WithKeyPrefix(prefix string) CacheOption {
+	return func(c *cacheConfig) {
+		c.keys.prefix = strings.TrimSuffix(prefix, ":")
+	}
+}
+
+// schemaVersion is a fingerprint of the cached models. Any change to their
+// fields, JSON tags or types produces a new version, so values written by an
+// older release of the library are never decoded into a newer shape.
+var schemaVersion = sync.OnceValue(func() string {
+	h := fnv.New32a()
+
+	seen := make(map[reflect.Type]bool)
+	for _, t := range []reflect.Type{
+		reflect.TypeFor[AnimeFull](),
+		reflect.TypeFor[Episode](),
+		reflect.TypeFor[PaginatedResponseBody[Anime]](),
+		reflect.TypeFor[PaginatedResponseBody[Episode]](),
+		reflect.TypeFor[PaginatedResponseBody[Season]](),
+		reflect.TypeFor[notFound](),
+	} {
+		writeTypeShape(h, t, seen)
+	}
+
+	return fmt.Sprintf("%08x", h.Sum32())
+})
+
+// versionLength is the length of a key version, "s" followed by 8 hex digits.
+const versionLength = 9
+
+// keyVersion will combine the schema version with the codec, so values are never
+// decoded with a different codec than they were written with.
+func keyVersion(codec Codec) string {
+	h := fnv.New32a()
+	_, _ = h.Write([]byte(schemaVersion() + ":" + codecName(codec)))
+
+	return fmt.Sprintf("s%08x", h.Sum32())
+}
+
+func writeTypeShape(w interface{ Write([]byte) (int, error) }, t reflect.Type, seen map[reflect.Type]bool) {
+	_, _ = w.Write([]byte(t.Kind().String() + "(" + t.Name() + ")"))
+
+	switch t.Kind() {
+	case reflect.Pointer, reflect.Slice, reflect.Array:
+		writeTypeShape(w, t.Elem(), seen)
+	case reflect.Map:
+		writeTypeShape(w, t.Key(), seen)
+		writeTypeShape(w, t.Elem(), seen)
+	case reflect.Struct:
+		if seen[t] {
+			return
+		}
+		seen[t] = true
+
+		for field := range t.Fields() {
+			_, _ = w.Write([]byte("{" + field.Name + " " + field.Tag.Get("json") + " "))
+			writeTypeShape(w, field.Type, seen)
+			_, _ = w.Write([]byte("}"))
+		}
+	}
+}
+
+// keyspace builds the cache keys for every resource.
+//
+// Keys take the form "<prefix>:<version>:<resource>:...", see keyVersion. Watch
+// state is the exception, see watchStates.
+type keyspace struct {
+	prefix  string
+	version string
+}
+
+func newKeyspace() keyspace {
+	return keyspace{prefix: defaultKeyPrefix, version: keyVersion(JSONCodec)}
+}
+
+// allVersions is a SCAN MATCH pattern for the keys of every version under the
+// prefix. The version segment is matched exactly, so a prefix such as "jikan"
+// does not match the keys of "jikan:prod".
+func (k keyspace) allVersions() string {
+	return redisx.EscapeGlob(k.prefix) + ":s" + strings.Repeat("[0-9a-f]", versionLength-1) + ":*"
+}
+
+// current is the prefix shared by keys of the current version.
+func (k keyspace) current() string {
+	return k.prefix + ":" + k.version + ":"
+}
+
+func (k keyspace) anime(id AnimeID) string {
+	return k.current() + "anime:" + id.String()
+}
+
+func (k keyspace) animeFull(id AnimeID) string {
+	return k.current() + "anime-full:" + id.String()
+}
+
+// episodes is the prefix of every episode key for an anime.
+func (k keyspace) episodes(id AnimeID) string {
+	return k.current() + "anime:" + id.String() + ":episode:"
+}
+
+func (k keyspace) episode(id AnimeID, ep int) string {
+	return k.episodes(id) + strconv.Itoa(ep)
+}
+
+func (k keyspace) notFoundAnime(id AnimeID) string {
+	return k.current() + "not-found:anime:" + id.String()
+}
+
+// notFoundEpisodes is the prefix of every episode not-found key for an anime.
+func (k keyspace) notFoundEpisodes(id AnimeID) string {
+	return k.notFoundAnime(id) + ":episode:"
+}
+
+func (k keyspace) notFoundEpisode(id AnimeID, ep int) string {
+	return k.notFoundEpisodes(id) + strconv.Itoa(ep)
+}
+
+func (k keyspace) list(resource string, path string) string {
+	return k.current() + "list:" + resource + ":" + path
+}
+
+// lastSeen is the key holding the last value written to key, see WithChangeHook.
+func (k keyspace) lastSeen(key string) string {
+	return k.current() + "seen:" + strings.TrimPrefix(key, k.current())
+}
+
+// watchStates is the prefix of every watch state. It is not versioned, watch
+// state is always stored as JSON so it survives upgrades and codec changes.
+func (k keyspace) watchStates() string {
+	return k.prefix + ":watch:"
+}
+
+func (k keyspace) watchState(id AnimeID) string {
+	return k.watchStates() + id.String()
+}
+
+// isWatchState reports whether key is a watch state of this prefix. The ID must
+// make up the rest of the key, so a prefix such as "jikan" does not claim the
+// keys of "jikan:watch".
+func (k keyspace) isWatchState(key string) bool {
+	id, ok := strings.CutPrefix(key, k.watchStates())
+	if !ok || id == "" {
+		return false
+	}
+
+	for _, r := range id {
+		if r < '0' || r > '9' {
+			return false
+		}
+	}
+
+	return true
+}
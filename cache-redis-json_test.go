@@ -0,0 +1,32 @@
+//go:build redis
+
+package jikan
+
+import (
+	"os"
+	"testing"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+)
+
+// TestRedisJSONCache needs a Redis server with the JSON module, e.g. redis-stack:
+//
+//	REDIS_ADDR=localhost:6379 go test -tags redis -run TestRedisJSONCache
+//
+// It flushes the selected database.
+func TestRedisJSONCache(t *testing.T) {
+	addr := os.Getenv("REDIS_ADDR")
+	if addr == "" {
+		t.Skip("REDIS_ADDR is not set")
+	}
+
+	client := redis.NewClient(&redis.Options{Addr: addr, Protocol: 2})
+	t.Cleanup(func() { _ = client.Close() })
+
+	if err := client.FlushDB(t.Context()).Err(); err != nil {
+		t.Fatal(err)
+	}
+
+	testRedisCaches(t, client, NewRedisJSONCache, time.Sleep)
+}
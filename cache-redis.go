@@ -0,0 +1,350 @@
+package jikan
+
+import (
+	"context"
+	"errors"
+	"maps"
+	"time"
+
+	"github.com/minnasync/jikan-go/internal/redisx"
+	"github.com/redis/go-redis/v9"
+	"golang.org/x/sync/singleflight"
+)
+
+type redisJSONCacheImpl[T any] struct {
+	sf     singleflight.Group
+	client *redis.Client
+}
+
+// newRedisJSONCache will create a Redis backend that stores values as RedisJSON documents.
+func newRedisJSONCache[T any](client *redis.Client) baseCache[T] {
+	return &redisJSONCacheImpl[T]{client: client}
+}
+
+func (c *redisJSONCacheImpl[T]) Get(ctx context.Context, key string) (*T, error) {
+	// Only the raw document is shared between concurrent callers, each decodes
+	// its own value.
+	result, err, _ := c.sf.Do(key, func() (any, error) {
+		return redisx.JSONGet(ctx, c.client, key, "$")
+	})
+
+	if errors.Is(err, redis.Nil) {
+		return nil, ErrCacheMiss
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	value := new(T)
+	if err := redisx.JSONDecodeFirst(result.(string), value); err != nil {
+		if errors.Is(err, redis.Nil) {
+			return nil, ErrCacheMiss
+		}
+
+		return nil, err
+	}
+
+	return value, nil
+}
+
+func (c *redisJSONCacheImpl[T]) BulkGet(ctx context.Context, keys []string) ([]*T, error) {
+	results, err := c.client.JSONMGet(ctx, "$", keys...).Result()
+	if err != nil {
+		return nil, err
+	}
+
+	values := make([]*T, len(keys))
+	for i, result := range results {
+		raw, ok := result.(string)
+		if !ok || raw == "" {
+			continue
+		}
+
+		value := new(T)
+		if err := redisx.JSONDecodeFirst(raw, value); err != nil {
+			if errors.Is(err, redis.Nil) {
+				continue
+			}
+
+			return nil, err
+		}
+
+		values[i] = value
+	}
+
+	return values, nil
+}
+
+func (c *redisJSONCacheImpl[T]) Set(ctx context.Context, key string, value T, opts *CacheOpts) error {
+	pipeline := c.client.Pipeline()
+
+	pipeline.JSONSet(ctx, key, "$", value)
+
+	if opts != nil && opts.TTL != nil {
+		pipeline.Expire(ctx, key, *opts.TTL)
+	}
+
+	_, err := pipeline.Exec(ctx)
+	return err
+}
+
+func (c *redisJSONCacheImpl[T]) BulkSet(ctx context.Context, keyValues map[string]T, opts *CacheOpts) error {
+	pipeline := c.client.Pipeline()
+
+	for key, value := range keyValues {
+		pipeline.JSONSet(ctx, key, "$", value)
+
+		if opts != nil && opts.TTL != nil {
+			pipeline.Expire(ctx, key, *opts.TTL)
+		}
+	}
+
+	_, err := pipeline.Exec(ctx)
+	return err
+}
+
+func (c *redisJSONCacheImpl[T]) Delete(ctx context.Context, key string) (int, error) {
+	deleted, err := c.client.JSONDel(ctx, key, "$").Result()
+	return int(deleted), err
+}
+
+func (c *redisJSONCacheImpl[T]) DeletePrefix(ctx context.Context, prefix string) (int, error) {
+	return redisx.DeletePrefix(ctx, c.client, prefix)
+}
+
+func (c *redisJSONCacheImpl[T]) DeleteFunc(ctx context.Context, prefix string, del func(key string) bool) (int, error) {
+	return redisx.DeletePatternFunc(ctx, c.client, redisx.EscapeGlob(prefix)+"*", del)
+}
+
+type redisCacheImpl[T any] struct {
+	sf     singleflight.Group
+	client *redis.Client
+	codec  Codec
+}
+
+// newRedisCache will create a Redis backend that stores values encoded with codec
+// as plain strings, it does not need any Redis modules.
+func newRedisCache[T any](client *redis.Client, codec Codec) baseCache[T] {
+	return &redisCacheImpl[T]{client: client, codec: codec}
+}
+
+func (c *redisCacheImpl[T]) Get(ctx context.Context, key string) (*T, error) {
+	result, err, _ := c.sf.Do(key, func() (any, error) {
+		return c.client.Get(ctx, key).Bytes()
+	})
+
+	if errors.Is(err, redis.Nil) {
+		return nil, ErrCacheMiss
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	value := new(T)
+	if err := c.codec.Unmarshal(result.([]byte), value); err != nil {
+		return nil, err
+	}
+
+	return value, nil
+}
+
+func (c *redisCacheImpl[T]) BulkGet(ctx context.Context, keys []string) ([]*T, error) {
+	results, err := c.client.MGet(ctx, keys...).Result()
+	if err != nil {
+		return nil, err
+	}
+
+	values := make([]*T, len(keys))
+	for i, result := range results {
+		raw, ok := result.(string)
+		if !ok {
+			continue
+		}
+
+		value := new(T)
+		if err := c.codec.Unmarshal([]byte(raw), value); err != nil {
+			return nil, err
+		}
+
+		values[i] = value
+	}
+
+	return values, nil
+}
+
+func (c *redisCacheImpl[T]) Set(ctx context.Context, key string, value T, opts *CacheOpts) error {
+	data, err := c.codec.Marshal(value)
+	if err != nil {
+		return err
+	}
+
+	return c.client.Set(ctx, key, data, ttlOf(opts)).Err()
+}
+
+func (c *redisCacheImpl[T]) BulkSet(ctx context.Context, keyValues map[string]T, opts *CacheOpts) error {
+	pipeline := c.client.Pipeline()
+
+	for key, value := range keyValues {
+		data, err := c.codec.Marshal(value)
+		if err != nil {
+			return err
+		}
+
+		pipeline.Set(ctx, key, data, ttlOf(opts))
+	}
+
+	_, err := pipeline.Exec(ctx)
+	return err
+}
+
+func (c *redisCacheImpl[T]) Delete(ctx context.Context, key string) (int, error) {
+	deleted, err := c.client.Del(ctx, key).Result()
+	return int(deleted), err
+}
+
+func (c *redisCacheImpl[T]) DeletePrefix(ctx context.Context, prefix string) (int, error) {
+	return redisx.DeletePrefix(ctx, c.client, prefix)
+}
+
+func (c *redisCacheImpl[T]) DeleteFunc(ctx context.Context, prefix string, del func(key string) bool) (int, error) {
+	return redisx.DeletePatternFunc(ctx, c.client, redisx.EscapeGlob(prefix)+"*", del)
+}
+
+// ttlOf will return the TTL in opts, zero means the value does not expire.
+func ttlOf(opts *CacheOpts) time.Duration {
+	if opts == nil || opts.TTL == nil {
+		return 0
+	}
+
+	return *opts.TTL
+}
+
+// redisCaches implements the Caches methods shared by every Redis cache manager.
+type redisCaches struct {
+	client *redis.Client
+	keys   keyspace
+
+	anime AnimeCache
+	lists ListCache
+	watch WatchStateCache
+}
+
+func (c *redisCaches) Anime() AnimeCache {
+	return c.anime
+}
+
+func (c *redisCaches) Lists() ListCache {
+	return c.lists
+}
+
+func (c *redisCaches) Watch() WatchStateCache {
+	return c.watch
+}
+
+func (c *redisCaches) InvalidateAnime(ctx context.Context, id string) error {
+	animeID, err := ParseAnimeID(id)
+	if err != nil {
+		return err
+	}
+
+	return c.InvalidateAnimeByMalID(ctx, animeID)
+}
+
+func (c *redisCaches) InvalidateAnimeByMalID(ctx context.Context, id AnimeID) error {
+	return errors.Join(
+		c.anime.InvalidateAnimeByMalID(ctx, id),
+		c.lists.InvalidateEpisodeListsByMalID(ctx, id),
+	)
+}
+
+// Purge will delete every key starting with prefix, all versioned resource types
+// share one keyspace so this is a single SCAN plus one for watch state.
+func (c *redisCaches) Purge(ctx context.Context, prefix string) error {
+	_, err := redisx.DeletePrefix(ctx, c.client, c.keys.current()+prefix)
+	return errors.Join(err, c.watch.Purge(ctx, prefix))
+}
+
+func (c *redisCaches) FlushAll(ctx context.Context) error {
+	_, err := redisx.DeletePattern(ctx, c.client, c.keys.allVersions())
+	return errors.Join(err, c.watch.Purge(ctx, "watch:"))
+}
+
+func (c *redisCaches) Stats() map[string]CacheStats {
+	stats := c.anime.Stats()
+	maps.Copy(stats, c.lists.Stats())
+	maps.Copy(stats, c.watch.Stats())
+
+	return stats
+}
+
+type RedisJSONCache struct {
+	redisCaches
+}
+
+// RedisJSONCache is a cache manager for Redis.
+//
+// This will only use Redis' JSON commands, so using this will require your Redis
+// instance to have the JSON module loaded. This is available on the redis-stack
+// builds.
+//
+// When setting up your redis.conf, all you need to do is add this line:
+// `loadmodule /opt/redis-stack/lib/rejson.so`
+func NewRedisJSONCache(client *redis.Client, opts ...CacheOption) Caches {
+	cfg := newCacheConfig(opts)
+
+	// Values are always RedisJSON documents, so WithCodec must not change the keyspace.
+	cfg.codec = JSONCodec
+	cfg.keys.version = keyVersion(cfg.codec)
+
+	return &RedisJSONCache{redisCaches{
+		client: client,
+		keys:   cfg.keys,
+		anime: newAnimeCache(
+			cfg,
+			newRedisJSONCache[Anime](client),
+			newRedisJSONCache[AnimeFull](client),
+			newRedisJSONCache[Episode](client),
+			newRedisJSONCache[notFound](client),
+		),
+		lists: newListCache(
+			cfg,
+			newRedisJSONCache[PaginatedResponseBody[Anime]](client),
+			newRedisJSONCache[PaginatedResponseBody[Episode]](client),
+			newRedisJSONCache[PaginatedResponseBody[Season]](client),
+		),
+		watch: newWatchStateCache(cfg, newRedisJSONCache[WatchState](client)),
+	}}
+}
+
+type RedisCache struct {
+	redisCaches
+}
+
+// NewRedisCache is a cache manager for Redis that stores values as plain strings.
+//
+// Values are encoded with the configured codec, JSON by default, so this works
+// with any Redis server.
+func NewRedisCache(client *redis.Client, opts ...CacheOption) Caches {
+	cfg := newCacheConfig(opts)
+
+	return &RedisCache{redisCaches{
+		client: client,
+		keys:   cfg.keys,
+		anime: newAnimeCache(
+			cfg,
+			newRedisCache[Anime](client, cfg.codec),
+			newRedisCache[AnimeFull](client, cfg.codec),
+			newRedisCache[Episode](client, cfg.codec),
+			newRedisCache[notFound](client, cfg.codec),
+		),
+		lists: newListCache(
+			cfg,
+			newRedisCache[PaginatedResponseBody[Anime]](client, cfg.codec),
+			newRedisCache[PaginatedResponseBody[Episode]](client, cfg.codec),
+			newRedisCache[PaginatedResponseBody[Season]](client, cfg.codec),
+		),
+		watch: newWatchStateCache(cfg, newRedisCache[WatchState](client, JSONCodec)),
+	}}
+}
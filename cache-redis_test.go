@@ -0,0 +1,116 @@
+package jikan
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/redis/go-redis/v9"
+)
+
+func TestRedisCache(t *testing.T) {
+	server := miniredis.RunT(t)
+	client := redis.NewClient(&redis.Options{Addr: server.Addr()})
+	t.Cleanup(func() { _ = client.Close() })
+
+	testRedisCaches(t, client, NewRedisCache, server.FastForward)
+}
+
+// testRedisCaches will exercise a Redis cache manager against client, expire
+// moves the server clock forward.
+func testRedisCaches(
+	t *testing.T,
+	client *redis.Client,
+	newCaches func(client *redis.Client, opts ...CacheOption) Caches,
+	expire func(d time.Duration),
+) {
+	ctx := t.Context()
+	keys := newCacheConfig([]CacheOption{WithKeyPrefix("jikan")}).keys
+	caches := newCaches(client, WithKeyPrefix("jikan"))
+	other := newCaches(client, WithKeyPrefix("jikan:watch"))
+
+	if err := caches.Anime().SetAnime(ctx, Anime{MalID: 1, Title: "Cowboy Bebop"}); err != nil {
+		t.Fatal(err)
+	}
+
+	anime, err := caches.Anime().GetAnimeByMalID(ctx, 1)
+	if err != nil || anime.Title != "Cowboy Bebop" {
+		t.Fatalf("unexpected anime: %v, %v", anime, err)
+	}
+
+	if err := caches.Anime().BulkSetAnime(ctx, []Anime{{MalID: 2}, {MalID: 3}}); err != nil {
+		t.Fatal(err)
+	}
+
+	values := peekMany(ctx, caches.Anime().AnimeCache(), []string{keys.anime(2), keys.anime(4), keys.anime(3)})
+	if values[0] == nil || values[0].MalID != 2 || values[1] != nil || values[2] == nil || values[2].MalID != 3 {
+		t.Fatalf("unexpected bulk get: %v", values)
+	}
+
+	if err := caches.Anime().InvalidateAnimeByMalID(ctx, 2); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := caches.Anime().GetAnimeByMalID(ctx, 2); !errors.Is(err, ErrCacheMiss) {
+		t.Fatalf("expected ErrCacheMiss after invalidating, got %v", err)
+	}
+
+	page := PaginatedResponseBody[Episode]{Data: []Episode{{MalID: 1}}}
+	if err := caches.Lists().SetEpisodeList(ctx, "page", page, time.Second); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := caches.Lists().GetEpisodeList(ctx, "page"); err != nil {
+		t.Fatal(err)
+	}
+
+	expire(2 * time.Second)
+
+	if _, err := caches.Lists().GetEpisodeList(ctx, "page"); !errors.Is(err, ErrCacheMiss) {
+		t.Fatalf("expected ErrCacheMiss once expired, got %v", err)
+	}
+
+	// Redis expires keys itself, so an expired entry is a plain miss.
+	if stats := caches.Stats()["episode-list"]; stats.Hits != 1 || stats.Misses != 1 || stats.Stale != 0 {
+		t.Fatalf("unexpected episode list stats: %+v", stats)
+	}
+
+	for _, cache := range []Caches{caches, other} {
+		if err := cache.Anime().SetAnime(ctx, Anime{MalID: 5}); err != nil {
+			t.Fatal(err)
+		}
+
+		if err := cache.Watch().SetWatchState(ctx, WatchState{ID: 1}); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if err := caches.Purge(ctx, ""); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := caches.Anime().GetAnimeByMalID(ctx, 5); !errors.Is(err, ErrCacheMiss) {
+		t.Fatalf("expected ErrCacheMiss after purging, got %v", err)
+	}
+
+	if _, err := caches.Watch().GetWatchState(ctx, 1); err != nil {
+		t.Fatalf("expected watch state to survive a purge, got %v", err)
+	}
+
+	if err := caches.FlushAll(ctx); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := caches.Watch().GetWatchState(ctx, 1); !errors.Is(err, ErrCacheMiss) {
+		t.Fatalf("expected watch state to be flushed, got %v", err)
+	}
+
+	if _, err := other.Anime().GetAnimeByMalID(ctx, 5); err != nil {
+		t.Fatalf("expected the longer prefix to keep its anime, got %v", err)
+	}
+
+	if _, err := other.Watch().GetWatchState(ctx, 1); err != nil {
+		t.Fatalf("expected the longer prefix to keep its watch state, got %v", err)
+	}
+}
@@ -0,0 +1,265 @@
+package jikan
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync/atomic"
+	"time"
+)
+
+var (
+	// errCacheExpired is returned by backends that found an entry past its TTL.
+	// It matches ErrCacheMiss so callers can treat it as a plain miss.
+	errCacheExpired = fmt.Errorf("%w: entry expired", ErrCacheMiss)
+)
+
+// CacheOp is the kind of operation performed on a cache.
+type CacheOp string
+
+const (
+	CacheOpGet     CacheOp = "get"
+	CacheOpSet     CacheOp = "set"
+	CacheOpBulkSet CacheOp = "bulk-set"
+	CacheOpDelete  CacheOp = "delete"
+)
+
+// CacheResult is the outcome of a cache operation.
+type CacheResult string
+
+const (
+	CacheResultHit   CacheResult = "hit"
+	CacheResultMiss  CacheResult = "miss"
+	CacheResultStale CacheResult = "stale"
+	CacheResultError CacheResult = "error"
+	CacheResultOK    CacheResult = "ok"
+)
+
+// CacheEvent describes a single cache operation, it is passed to a CacheHook.
+type CacheEvent struct {
+	// Resource is the cached resource type, e.g. "anime" or "anime-list".
+	Resource string
+	Op       CacheOp
+	Result   CacheResult
+	Latency  time.Duration
+	// Err is set when Result is CacheResultError.
+	Err error
+}
+
+// CacheHook receives an event for every cache operation.
+//
+// Hooks are called synchronously, so implementations should be cheap and must be
+// safe for concurrent use.
+type CacheHook interface {
+	OnCacheEvent(ctx context.Context, event CacheEvent)
+}
+
+// CacheHookFunc is an adapter to allow the use of ordinary functions as a CacheHook.
+type CacheHookFunc func(ctx context.Context, event CacheEvent)
+
+func (f CacheHookFunc) OnCacheEvent(ctx context.Context, event CacheEvent) {
+	f(ctx, event)
+}
+
+// WithCacheHook will send every cache operation to hook.
+func WithCacheHook(hook CacheHook) CacheOption {
+	return func(c *cacheConfig) {
+		c.hook = hook
+	}
+}
+
+// CacheStats are the counters for a single cached resource type.
+type CacheStats struct {
+	Resource string
+
+	Hits   uint64
+	Misses uint64
+	// Stale counts lookups of expired entries. Only the in-memory cache keeps
+	// those, Redis expires keys itself so they count as misses.
+	Stale     uint64
+	Errors    uint64
+	Evictions uint64
+
+	// Operations is the number of operations timed in Latency.
+	Operations uint64
+	// Latency is the total time spent in cache operations.
+	Latency time.Duration
+}
+
+// HitRatio will return the share of lookups that were hits, stale entries count as misses.
+func (s CacheStats) HitRatio() float64 {
+	lookups := s.Hits + s.Misses + s.Stale
+	if lookups == 0 {
+		return 0
+	}
+
+	return float64(s.Hits) / float64(lookups)
+}
+
+// AverageLatency will return the mean latency of a cache operation.
+func (s CacheStats) AverageLatency() time.Duration {
+	if s.Operations == 0 {
+		return 0
+	}
+
+	return s.Latency / time.Duration(s.Operations)
+}
+
+type cacheCounters struct {
+	hits       atomic.Uint64
+	misses     atomic.Uint64
+	stale      atomic.Uint64
+	errors     atomic.Uint64
+	evictions  atomic.Uint64
+	operations atomic.Uint64
+	latency    atomic.Int64
+}
+
+// instrumentedCache wraps a baseCache and records statistics for it.
+type instrumentedCache[T any] struct {
+	resource string
+	hook     CacheHook
+	next     baseCache[T]
+	counters cacheCounters
+}
+
+func newInstrumentedCache[T any](resource string, cfg cacheConfig, next baseCache[T]) baseCache[T] {
+	return &instrumentedCache[T]{resource: resource, hook: cfg.hook, next: next}
+}
+
+func (c *instrumentedCache[T]) record(ctx context.Context, op CacheOp, result CacheResult, start time.Time, err error) {
+	latency := time.Since(start)
+
+	c.counters.operations.Add(1)
+	c.counters.latency.Add(int64(latency))
+
+	switch result {
+	case CacheResultHit:
+		c.counters.hits.Add(1)
+	case CacheResultMiss:
+		c.counters.misses.Add(1)
+	case CacheResultStale:
+		// Expired entries are removed on read.
+		c.counters.stale.Add(1)
+		c.counters.evictions.Add(1)
+	case CacheResultError:
+		c.counters.errors.Add(1)
+	}
+
+	if c.hook != nil {
+		c.hook.OnCacheEvent(ctx, CacheEvent{
+			Resource: c.resource,
+			Op:       op,
+			Result:   result,
+			Latency:  latency,
+			Err:      err,
+		})
+	}
+}
+
+func (c *instrumentedCache[T]) Get(ctx context.Context, key string) (*T, error) {
+	start := time.Now()
+
+	value, err := c.next.Get(ctx, key)
+	switch {
+	case err == nil:
+		c.record(ctx, CacheOpGet, CacheResultHit, start, nil)
+	case errors.Is(err, errCacheExpired):
+		c.record(ctx, CacheOpGet, CacheResultStale, start, nil)
+	case errors.Is(err, ErrCacheMiss):
+		c.record(ctx, CacheOpGet, CacheResultMiss, start, nil)
+	default:
+		c.record(ctx, CacheOpGet, CacheResultError, start, err)
+	}
+
+	return value, err
+}
+
+func (c *instrumentedCache[T]) Set(ctx context.Context, key string, value T, opts *CacheOpts) error {
+	start := time.Now()
+
+	err := c.next.Set(ctx, key, value, opts)
+	c.record(ctx, CacheOpSet, resultOf(err), start, err)
+
+	return err
+}
+
+func (c *instrumentedCache[T]) BulkSet(ctx context.Context, keyValues map[string]T, opts *CacheOpts) error {
+	start := time.Now()
+
+	err := c.next.BulkSet(ctx, keyValues, opts)
+	c.record(ctx, CacheOpBulkSet, resultOf(err), start, err)
+
+	return err
+}
+
+func (c *instrumentedCache[T]) Delete(ctx context.Context, key string) (int, error) {
+	start := time.Now()
+
+	deleted, err := c.next.Delete(ctx, key)
+	c.counters.evictions.Add(uint64(deleted))
+	c.record(ctx, CacheOpDelete, resultOf(err), start, err)
+
+	return deleted, err
+}
+
+func (c *instrumentedCache[T]) DeletePrefix(ctx context.Context, prefix string) (int, error) {
+	start := time.Now()
+
+	deleted, err := c.next.DeletePrefix(ctx, prefix)
+	c.counters.evictions.Add(uint64(deleted))
+	c.record(ctx, CacheOpDelete, resultOf(err), start, err)
+
+	return deleted, err
+}
+
+func (c *instrumentedCache[T]) DeleteFunc(ctx context.Context, prefix string, del func(key string) bool) (int, error) {
+	start := time.Now()
+
+	deleted, err := c.next.DeleteFunc(ctx, prefix, del)
+	c.counters.evictions.Add(uint64(deleted))
+	c.record(ctx, CacheOpDelete, resultOf(err), start, err)
+
+	return deleted, err
+}
+
+func (c *instrumentedCache[T]) Stats() CacheStats {
+	return CacheStats{
+		Resource:   c.resource,
+		Hits:       c.counters.hits.Load(),
+		Misses:     c.counters.misses.Load(),
+		Stale:      c.counters.stale.Load(),
+		Errors:     c.counters.errors.Load(),
+		Evictions:  c.counters.evictions.Load(),
+		Operations: c.counters.operations.Load(),
+		Latency:    time.Duration(c.counters.latency.Load()),
+	}
+}
+
+func resultOf(err error) CacheResult {
+	if err != nil {
+		return CacheResultError
+	}
+
+	return CacheResultOK
+}
+
+type statsProvider interface {
+	Stats() CacheStats
+}
+
+// collectStats will key the statistics of each instrumented cache by its resource name.
+func collectStats(caches ...any) map[string]CacheStats {
+	stats := make(map[string]CacheStats, len(caches))
+	for _, cache := range caches {
+		provider, ok := cache.(statsProvider)
+		if !ok {
+			continue
+		}
+
+		s := provider.Stats()
+		stats[s.Resource] = s
+	}
+
+	return stats
+}
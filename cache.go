@@ -4,13 +4,10 @@ import (
 	"context"
 	"errors"
 	"fmt"
-	"strconv"
+	"maps"
+	"strings"
 	"sync"
 	"time"
-
-	"github.com/minnasync/jikan-go/internal/redisx"
-	"github.com/redis/go-redis/v9"
-	"golang.org/x/sync/singleflight"
 )
 
 var (
@@ -21,6 +18,56 @@ type CacheOpts struct {
 	TTL *time.Duration
 }
 
+const (
+	// defaultNotFoundTTL is how long a 404 from Jikan is remembered by default.
+	defaultNotFoundTTL = time.Minute * 10
+)
+
+type cacheConfig struct {
+	keys        keyspace
+	codec       Codec
+	notFoundTTL time.Duration
+	hook        CacheHook
+	changeHook  ChangeHook
+}
+
+func newCacheConfig(opts []CacheOption) cacheConfig {
+	cfg := cacheConfig{
+		keys:        newKeyspace(),
+		codec:       JSONCodec,
+		notFoundTTL: defaultNotFoundTTL,
+	}
+
+	for _, opt := range opts {
+		opt(&cfg)
+	}
+
+	if cfg.keys.prefix == "" {
+		cfg.keys.prefix = defaultKeyPrefix
+	}
+
+	cfg.keys.version = keyVersion(cfg.codec)
+
+	return cfg
+}
+
+// CacheOption configures a cache manager created by NewCache or NewRedisJSONCache.
+type CacheOption func(*cacheConfig)
+
+// WithNotFoundTTL will set how long not-found results are cached for.
+//
+// A zero or negative duration disables negative caching.
+func WithNotFoundTTL(ttl time.Duration) CacheOption {
+	return func(c *cacheConfig) {
+		c.notFoundTTL = ttl
+	}
+}
+
+// notFound is the marker stored for resources Jikan responded to with a 404.
+type notFound struct {
+	Resource string `json:"resource"`
+}
+
 // baseCache is a simple inferface for implementing methods for advanced caching.
 type baseCache[T any] interface {
 	// Get will get a value from the cache.
@@ -29,34 +76,86 @@ type baseCache[T any] interface {
 	Set(ctx context.Context, key string, value T, opts *CacheOpts) error
 	// BulkSet will set multiple values in the cache.
 	BulkSet(ctx context.Context, keyValues map[string]T, opts *CacheOpts) error
-	// Delete will delete a value from the cache.
-	Delete(ctx context.Context, key string) error
+	// Delete will delete a value from the cache, returning 1 if it existed and 0 otherwise.
+	Delete(ctx context.Context, key string) (int, error)
+	// DeletePrefix will delete every value whose key starts with prefix, returning how many were deleted.
+	DeletePrefix(ctx context.Context, prefix string) (int, error)
+	// DeleteFunc will delete every value whose key starts with prefix and for
+	// which del returns true, returning how many were deleted.
+	DeleteFunc(ctx context.Context, prefix string, del func(key string) bool) (int, error)
+}
+
+// bulkGetter is implemented by backends that can read many keys at once. Values
+// are returned in the order of keys, nil for keys that are missing.
+type bulkGetter[T any] interface {
+	BulkGet(ctx context.Context, keys []string) ([]*T, error)
 }
 
 type AnimeCache interface {
 	AnimeCache() baseCache[Anime]
 	AnimeFullCache() baseCache[AnimeFull]
 	EpisodeCache() baseCache[Episode]
+	NotFoundCache() baseCache[notFound]
 
+	// The string ID methods parse the ID with ParseAnimeID and call their ByMalID
+	// variant, an invalid ID matches ErrInvalidQuery.
 	GetAnime(ctx context.Context, id string) (*Anime, error)
+	GetAnimeByMalID(ctx context.Context, id AnimeID) (*Anime, error)
 	GetAnimeFull(ctx context.Context, id string) (*AnimeFull, error)
+	GetAnimeFullByMalID(ctx context.Context, id AnimeID) (*AnimeFull, error)
 	SetAnime(ctx context.Context, data Anime) error
 	SetAnimeFull(ctx context.Context, data AnimeFull) error
 	BulkSetAnime(ctx context.Context, data []Anime) error
+	SetAnimeNotFound(ctx context.Context, id string) error
+	SetAnimeNotFoundByMalID(ctx context.Context, id AnimeID) error
 
 	GetEpisode(ctx context.Context, id string, ep int) (*Episode, error)
+	GetEpisodeByMalID(ctx context.Context, id AnimeID, ep int) (*Episode, error)
 	SetEpisode(ctx context.Context, id string, data Episode) error
+	SetEpisodeByMalID(ctx context.Context, id AnimeID, data Episode) error
 	BulkSetEpisodes(ctx context.Context, id string, data []Episode) error
+	BulkSetEpisodesByMalID(ctx context.Context, id AnimeID, data []Episode) error
+	SetEpisodeNotFound(ctx context.Context, id string, ep int) error
+	SetEpisodeNotFoundByMalID(ctx context.Context, id AnimeID, ep int) error
+
+	// InvalidateAnime will delete an anime, its full record, its episodes and any
+	// not-found results for them.
+	InvalidateAnime(ctx context.Context, id string) error
+	InvalidateAnimeByMalID(ctx context.Context, id AnimeID) error
+	// Purge will delete every anime and episode entry whose key starts with prefix.
+	// The prefix is relative to the key namespace, e.g. "anime-full:".
+	Purge(ctx context.Context, prefix string) error
+
+	Stats() map[string]CacheStats
 }
 
 type animeCacheImpl struct {
+	cfg cacheConfig
+
 	anime     baseCache[Anime]
 	animeFull baseCache[AnimeFull]
 	episodes  baseCache[Episode]
+	notFound  baseCache[notFound]
+}
+
+func newAnimeCache(
+	cfg cacheConfig,
+	anime baseCache[Anime],
+	animeFull baseCache[AnimeFull],
+	episodes baseCache[Episode],
+	notFound baseCache[notFound],
+) AnimeCache {
+	return &animeCacheImpl{
+		cfg:       cfg,
+		anime:     newInstrumentedCache("anime", cfg, anime),
+		animeFull: newInstrumentedCache("anime-full", cfg, animeFull),
+		episodes:  newInstrumentedCache("episode", cfg, episodes),
+		notFound:  newInstrumentedCache("not-found", cfg, notFound),
+	}
 }
 
-func newAnimeCache(anime baseCache[Anime], animeFull baseCache[AnimeFull], episodes baseCache[Episode]) AnimeCache {
-	return &animeCacheImpl{anime: anime, animeFull: animeFull, episodes: episodes}
+func (c animeCacheImpl) Stats() map[string]CacheStats {
+	return collectStats(c.anime, c.animeFull, c.episodes, c.notFound)
 }
 
 func (c animeCacheImpl) AnimeCache() baseCache[Anime] {
@@ -71,73 +170,446 @@ func (c animeCacheImpl) EpisodeCache() baseCache[Episode] {
 	return c.episodes
 }
 
+func (c animeCacheImpl) NotFoundCache() baseCache[notFound] {
+	return c.notFound
+}
+
+// checkNotFound will turn a cache miss into a *NotFoundError when a not-found
+// marker exists for key.
+func (c animeCacheImpl) checkNotFound(ctx context.Context, key string, resource string, id string, err error) error {
+	if !errors.Is(err, ErrCacheMiss) && err != nil {
+		return err
+	}
+
+	if _, nfErr := c.notFound.Get(ctx, key); nfErr == nil {
+		return &NotFoundError{Resource: resource, ID: id}
+	}
+
+	return err
+}
+
+func (c animeCacheImpl) setNotFound(ctx context.Context, key string, resource string) error {
+	if c.cfg.notFoundTTL <= 0 {
+		return nil
+	}
+
+	return c.notFound.Set(ctx, key, notFound{Resource: resource}, &CacheOpts{
+		TTL: &c.cfg.notFoundTTL,
+	})
+}
+
 func (c animeCacheImpl) GetAnime(ctx context.Context, id string) (*Anime, error) {
-	return c.anime.Get(ctx, "jikan:anime:"+id)
+	animeID, err := ParseAnimeID(id)
+	if err != nil {
+		return nil, err
+	}
+
+	return c.GetAnimeByMalID(ctx, animeID)
+}
+
+func (c animeCacheImpl) GetAnimeByMalID(ctx context.Context, id AnimeID) (*Anime, error) {
+	info, err := c.anime.Get(ctx, c.cfg.keys.anime(id))
+	if err != nil {
+		return nil, c.checkNotFound(ctx, c.cfg.keys.notFoundAnime(id), "anime", id.String(), err)
+	}
+
+	return info, nil
 }
 
 func (c animeCacheImpl) GetAnimeFull(ctx context.Context, id string) (*AnimeFull, error) {
-	return c.animeFull.Get(ctx, "jikan:anime-full:"+id)
+	animeID, err := ParseAnimeID(id)
+	if err != nil {
+		return nil, err
+	}
+
+	return c.GetAnimeFullByMalID(ctx, animeID)
+}
+
+func (c animeCacheImpl) GetAnimeFullByMalID(ctx context.Context, id AnimeID) (*AnimeFull, error) {
+	info, err := c.animeFull.Get(ctx, c.cfg.keys.animeFull(id))
+	if err != nil {
+		return nil, c.checkNotFound(ctx, c.cfg.keys.notFoundAnime(id), "anime", id.String(), err)
+	}
+
+	return info, nil
+}
+
+func (c animeCacheImpl) SetAnimeNotFound(ctx context.Context, id string) error {
+	animeID, err := ParseAnimeID(id)
+	if err != nil {
+		return err
+	}
+
+	return c.SetAnimeNotFoundByMalID(ctx, animeID)
+}
+
+func (c animeCacheImpl) SetAnimeNotFoundByMalID(ctx context.Context, id AnimeID) error {
+	return c.setNotFound(ctx, c.cfg.keys.notFoundAnime(id), "anime")
 }
 
 func (c animeCacheImpl) SetAnime(ctx context.Context, data Anime) error {
-	return c.anime.Set(ctx, "jikan:anime:"+strconv.Itoa(data.MalID), data, &CacheOpts{
+	return setAndDiff(ctx, c.cfg, c.anime, c.cfg.keys.anime(AnimeID(data.MalID)), data, &CacheOpts{
 		TTL: new(time.Hour * 24),
-	})
+	}, DiffAnime)
 }
 
 func (c animeCacheImpl) SetAnimeFull(ctx context.Context, data AnimeFull) error {
 	// If the full value is fetched, we can set the base as well.
-	// Makes sense to do since it's the same info.
-	if err := c.SetAnime(ctx, data.Anime); err != nil {
+	// Makes sense to do since it's the same info. The full diff already covers
+	// the base fields, so only that one is reported.
+	if err := c.anime.Set(ctx, c.cfg.keys.anime(AnimeID(data.MalID)), data.Anime, &CacheOpts{
+		TTL: new(time.Hour * 24),
+	}); err != nil {
 		return err
 	}
 
-	return c.animeFull.Set(ctx, "jikan:anime-full:"+strconv.Itoa(data.MalID), data, &CacheOpts{
+	return setAndDiff(ctx, c.cfg, c.animeFull, c.cfg.keys.animeFull(AnimeID(data.MalID)), data, &CacheOpts{
 		TTL: new(time.Hour * 24),
-	})
+	}, DiffAnimeFull)
 }
 
 func (c animeCacheImpl) BulkSetAnime(ctx context.Context, data []Anime) error {
 	entries := make(map[string]Anime, len(data))
 	for _, entry := range data {
-		entries["jikan:anime:"+strconv.Itoa(entry.MalID)] = entry
+		entries[c.cfg.keys.anime(AnimeID(entry.MalID))] = entry
+	}
+
+	return bulkSetAndDiff(ctx, c.cfg, c.anime, entries, nil, DiffAnime)
+}
+
+func (c animeCacheImpl) InvalidateAnime(ctx context.Context, id string) error {
+	animeID, err := ParseAnimeID(id)
+	if err != nil {
+		return err
 	}
 
-	return c.anime.BulkSet(ctx, entries, nil)
+	return c.InvalidateAnimeByMalID(ctx, animeID)
+}
+
+func (c animeCacheImpl) InvalidateAnimeByMalID(ctx context.Context, id AnimeID) error {
+	return errors.Join(
+		deleteKey(ctx, c.anime, c.cfg.keys.anime(id)),
+		deleteKey(ctx, c.animeFull, c.cfg.keys.animeFull(id)),
+		deleteKey(ctx, c.notFound, c.cfg.keys.notFoundAnime(id)),
+		deletePrefix(ctx, c.episodes, c.cfg.keys.episodes(id)),
+		deletePrefix(ctx, c.notFound, c.cfg.keys.notFoundEpisodes(id)),
+	)
+}
+
+func (c animeCacheImpl) Purge(ctx context.Context, prefix string) error {
+	prefix = c.cfg.keys.current() + prefix
+
+	return errors.Join(
+		deletePrefix(ctx, c.anime, prefix),
+		deletePrefix(ctx, c.animeFull, prefix),
+		deletePrefix(ctx, c.episodes, prefix),
+		deletePrefix(ctx, c.notFound, prefix),
+	)
 }
 
 func (c animeCacheImpl) GetEpisode(ctx context.Context, id string, ep int) (*Episode, error) {
-	return c.episodes.Get(ctx, fmt.Sprintf("jikan:anime:%s:episode:%d", id, ep))
+	animeID, err := ParseAnimeID(id)
+	if err != nil {
+		return nil, err
+	}
+
+	return c.GetEpisodeByMalID(ctx, animeID, ep)
+}
+
+func (c animeCacheImpl) GetEpisodeByMalID(ctx context.Context, id AnimeID, ep int) (*Episode, error) {
+	episode, err := c.episodes.Get(ctx, c.cfg.keys.episode(id, ep))
+	if err != nil {
+		return nil, c.checkNotFound(ctx, c.cfg.keys.notFoundEpisode(id, ep), "episode", fmt.Sprintf("%d/%d", id, ep), err)
+	}
+
+	return episode, nil
+}
+
+func (c animeCacheImpl) SetEpisodeNotFound(ctx context.Context, id string, ep int) error {
+	animeID, err := ParseAnimeID(id)
+	if err != nil {
+		return err
+	}
+
+	return c.SetEpisodeNotFoundByMalID(ctx, animeID, ep)
+}
+
+func (c animeCacheImpl) SetEpisodeNotFoundByMalID(ctx context.Context, id AnimeID, ep int) error {
+	return c.setNotFound(ctx, c.cfg.keys.notFoundEpisode(id, ep), "episode")
 }
 
 func (c *animeCacheImpl) SetEpisode(ctx context.Context, id string, data Episode) error {
-	return c.episodes.Set(ctx, fmt.Sprintf("jikan:anime:%s:episode:%d", id, data.MalID), data, &CacheOpts{
+	animeID, err := ParseAnimeID(id)
+	if err != nil {
+		return err
+	}
+
+	return c.SetEpisodeByMalID(ctx, animeID, data)
+}
+
+func (c *animeCacheImpl) SetEpisodeByMalID(ctx context.Context, id AnimeID, data Episode) error {
+	return setAndDiff(ctx, c.cfg, c.episodes, c.cfg.keys.episode(id, data.MalID), data, &CacheOpts{
 		TTL: new(time.Hour * 24),
+	}, func(old, new *Episode) Diff {
+		return DiffEpisode(id, old, new)
 	})
 }
 
 func (c *animeCacheImpl) BulkSetEpisodes(ctx context.Context, id string, data []Episode) error {
+	animeID, err := ParseAnimeID(id)
+	if err != nil {
+		return err
+	}
+
+	return c.BulkSetEpisodesByMalID(ctx, animeID, data)
+}
+
+func (c *animeCacheImpl) BulkSetEpisodesByMalID(ctx context.Context, id AnimeID, data []Episode) error {
 	entries := make(map[string]Episode, len(data))
 	for _, entry := range data {
-		entries[fmt.Sprintf("jikan:anime:%s:episode:%d", id, entry.MalID)] = entry
+		entries[c.cfg.keys.episode(id, entry.MalID)] = entry
 	}
 
+	// Episodes are written in bulk from an episode page, which is compared as a
+	// whole by SetEpisodeList.
 	return c.episodes.BulkSet(ctx, entries, nil)
 }
 
-type inMemoryCacheEntry[T any] struct {
-	value   T
+// ListCache caches whole list and search pages, including their pagination.
+//
+// Keys are the request path with a normalized query string, so two requests for
+// the same page share an entry regardless of parameter order.
+type ListCache interface {
+	AnimeListCache() baseCache[PaginatedResponseBody[Anime]]
+	EpisodeListCache() baseCache[PaginatedResponseBody[Episode]]
+	SeasonListCache() baseCache[PaginatedResponseBody[Season]]
+
+	GetAnimeList(ctx context.Context, key string) (*PaginatedResponseBody[Anime], error)
+	SetAnimeList(ctx context.Context, key string, data PaginatedResponseBody[Anime], ttl time.Duration) error
+
+	GetEpisodeList(ctx context.Context, key string) (*PaginatedResponseBody[Episode], error)
+	SetEpisodeList(ctx context.Context, key string, data PaginatedResponseBody[Episode], ttl time.Duration) error
+
+	GetSeasonList(ctx context.Context, key string) (*PaginatedResponseBody[Season], error)
+	SetSeasonList(ctx context.Context, key string, data PaginatedResponseBody[Season], ttl time.Duration) error
+
+	// InvalidateEpisodeLists will delete every cached episode page for an anime.
+	InvalidateEpisodeLists(ctx context.Context, id string) error
+	InvalidateEpisodeListsByMalID(ctx context.Context, id AnimeID) error
+	// Purge will delete every list entry whose key starts with prefix.
+	// The prefix is relative to the key namespace, e.g. "list:anime:".
+	Purge(ctx context.Context, prefix string) error
+
+	Stats() map[string]CacheStats
+}
+
+const (
+	// Time-to-live values for cached list pages. Rankings and seasonal charts move
+	// slowly, search results less so.
+	searchListTTL   = time.Hour
+	topListTTL      = time.Hour * 6
+	seasonListTTL   = time.Hour * 6
+	episodeListTTL  = time.Hour * 6
+	seasonsIndexTTL = time.Hour * 24
+)
+
+type listCacheImpl struct {
+	cfg cacheConfig
+
+	anime    baseCache[PaginatedResponseBody[Anime]]
+	episodes baseCache[PaginatedResponseBody[Episode]]
+	seasons  baseCache[PaginatedResponseBody[Season]]
+}
+
+func newListCache(
+	cfg cacheConfig,
+	anime baseCache[PaginatedResponseBody[Anime]],
+	episodes baseCache[PaginatedResponseBody[Episode]],
+	seasons baseCache[PaginatedResponseBody[Season]],
+) ListCache {
+	return &listCacheImpl{
+		cfg:      cfg,
+		anime:    newInstrumentedCache("anime-list", cfg, anime),
+		episodes: newInstrumentedCache("episode-list", cfg, episodes),
+		seasons:  newInstrumentedCache("season-list", cfg, seasons),
+	}
+}
+
+func (c listCacheImpl) Stats() map[string]CacheStats {
+	return collectStats(c.anime, c.episodes, c.seasons)
+}
+
+func (c listCacheImpl) AnimeListCache() baseCache[PaginatedResponseBody[Anime]] {
+	return c.anime
+}
+
+func (c listCacheImpl) EpisodeListCache() baseCache[PaginatedResponseBody[Episode]] {
+	return c.episodes
+}
+
+func (c listCacheImpl) SeasonListCache() baseCache[PaginatedResponseBody[Season]] {
+	return c.seasons
+}
+
+func (c listCacheImpl) GetAnimeList(ctx context.Context, key string) (*PaginatedResponseBody[Anime], error) {
+	return c.anime.Get(ctx, c.cfg.keys.list("anime", key))
+}
+
+func (c listCacheImpl) SetAnimeList(ctx context.Context, key string, data PaginatedResponseBody[Anime], ttl time.Duration) error {
+	return c.anime.Set(ctx, c.cfg.keys.list("anime", key), data, &CacheOpts{TTL: &ttl})
+}
+
+func (c listCacheImpl) GetEpisodeList(ctx context.Context, key string) (*PaginatedResponseBody[Episode], error) {
+	return c.episodes.Get(ctx, c.cfg.keys.list("episode", key))
+}
+
+func (c listCacheImpl) SetEpisodeList(ctx context.Context, key string, data PaginatedResponseBody[Episode], ttl time.Duration) error {
+	return setAndDiff(ctx, c.cfg, c.episodes, c.cfg.keys.list("episode", key), data, &CacheOpts{TTL: &ttl},
+		func(old, new *PaginatedResponseBody[Episode]) Diff {
+			return DiffEpisodes(animeIDFromPath(key), old.Data, new.Data)
+		})
+}
+
+func (c listCacheImpl) GetSeasonList(ctx context.Context, key string) (*PaginatedResponseBody[Season], error) {
+	return c.seasons.Get(ctx, c.cfg.keys.list("season", key))
+}
+
+func (c listCacheImpl) SetSeasonList(ctx context.Context, key string, data PaginatedResponseBody[Season], ttl time.Duration) error {
+	return c.seasons.Set(ctx, c.cfg.keys.list("season", key), data, &CacheOpts{TTL: &ttl})
+}
+
+func (c listCacheImpl) InvalidateEpisodeLists(ctx context.Context, id string) error {
+	animeID, err := ParseAnimeID(id)
+	if err != nil {
+		return err
+	}
+
+	return c.InvalidateEpisodeListsByMalID(ctx, animeID)
+}
+
+func (c listCacheImpl) InvalidateEpisodeListsByMalID(ctx context.Context, id AnimeID) error {
+	return deletePrefix(ctx, c.episodes, c.cfg.keys.list("episode", "/v4/anime/"+id.String()+"/episodes"))
+}
+
+func (c listCacheImpl) Purge(ctx context.Context, prefix string) error {
+	prefix = c.cfg.keys.current() + prefix
+
+	return errors.Join(
+		deletePrefix(ctx, c.anime, prefix),
+		deletePrefix(ctx, c.episodes, prefix),
+		deletePrefix(ctx, c.seasons, prefix),
+	)
+}
+
+// WatchState is what a Watcher last saw of an anime.
+type WatchState struct {
+	ID     AnimeID      `json:"id"`
+	Status *AnimeStatus `json:"status"`
+	Score  *float64     `json:"score"`
+	// Episodes maps every known episode number to whether it had aired.
+	Episodes  map[int]bool `json:"episodes"`
+	CheckedAt time.Time    `json:"checked_at"`
+}
+
+// WatchStateCache persists the state of a Watcher between polls and restarts.
+//
+// Entries are stored as JSON outside the versioned keyspace, so they are kept
+// across library upgrades and codec changes. They do not expire, they are only
+// removed by DeleteWatchState, Purge or FlushAll.
+type WatchStateCache interface {
+	WatchStateCache() baseCache[WatchState]
+
+	GetWatchState(ctx context.Context, id AnimeID) (*WatchState, error)
+	SetWatchState(ctx context.Context, state WatchState) error
+	DeleteWatchState(ctx context.Context, id AnimeID) error
+	// Purge will delete every watch state whose key starts with prefix. The
+	// prefix is relative to the key namespace, e.g. "watch:" for every watch
+	// state or "watch:1", any other prefix deletes nothing.
+	Purge(ctx context.Context, prefix string) error
+
+	Stats() map[string]CacheStats
+}
+
+type watchStateCacheImpl struct {
+	cfg cacheConfig
+
+	states baseCache[WatchState]
+}
+
+func newWatchStateCache(cfg cacheConfig, states baseCache[WatchState]) WatchStateCache {
+	return &watchStateCacheImpl{
+		cfg:    cfg,
+		states: newInstrumentedCache("watch-state", cfg, states),
+	}
+}
+
+func (c watchStateCacheImpl) Stats() map[string]CacheStats {
+	return collectStats(c.states)
+}
+
+func (c watchStateCacheImpl) WatchStateCache() baseCache[WatchState] {
+	return c.states
+}
+
+func (c watchStateCacheImpl) GetWatchState(ctx context.Context, id AnimeID) (*WatchState, error) {
+	return c.states.Get(ctx, c.cfg.keys.watchState(id))
+}
+
+func (c watchStateCacheImpl) SetWatchState(ctx context.Context, state WatchState) error {
+	return c.states.Set(ctx, c.cfg.keys.watchState(state.ID), state, nil)
+}
+
+func (c watchStateCacheImpl) DeleteWatchState(ctx context.Context, id AnimeID) error {
+	return deleteKey(ctx, c.states, c.cfg.keys.watchState(id))
+}
+
+// Purge only deletes watch state for prefixes starting with "watch:", the
+// versioned caches never hold keys under it.
+func (c watchStateCacheImpl) Purge(ctx context.Context, prefix string) error {
+	if !strings.HasPrefix(prefix, "watch:") {
+		return nil
+	}
+
+	_, err := c.states.DeleteFunc(ctx, c.cfg.keys.prefix+":"+prefix, c.cfg.keys.isWatchState)
+	return err
+}
+
+// deleteKey will call Delete on cache, discarding the count.
+func deleteKey[T any](ctx context.Context, cache baseCache[T], key string) error {
+	_, err := cache.Delete(ctx, key)
+	return err
+}
+
+// deletePrefix will call DeletePrefix on cache, discarding the count.
+func deletePrefix[T any](ctx context.Context, cache baseCache[T], prefix string) error {
+	_, err := cache.DeletePrefix(ctx, prefix)
+	return err
+}
+
+type inMemoryCacheEntry struct {
+	value   []byte
 	expires time.Time
 }
 
+// inMemorySweepInterval is the number of writes between sweeps of expired entries.
+const inMemorySweepInterval = 1024
+
+// inMemoryCacheImpl stores encoded values rather than live Go values, so every
+// Get returns a fresh copy and callers can never mutate what is cached.
+//
+// Expired entries are removed when read and swept every inMemorySweepInterval
+// writes, so entries that are never read again do not pile up.
 type inMemoryCacheImpl[T any] struct {
 	mu      sync.RWMutex
-	entries map[string]inMemoryCacheEntry[T]
+	codec   Codec
+	entries map[string]inMemoryCacheEntry
+	writes  int
 }
 
-func newInMemoryCache[T any]() baseCache[T] {
+func newInMemoryCache[T any](codec Codec) baseCache[T] {
 	return &inMemoryCacheImpl[T]{
-		entries: make(map[string]inMemoryCacheEntry[T]),
+		codec:   codec,
+		entries: make(map[string]inMemoryCacheEntry),
 	}
 }
 
@@ -151,16 +623,44 @@ func (c *inMemoryCacheImpl[T]) Get(ctx context.Context, key string) (*T, error)
 	}
 
 	if !entry.expires.IsZero() && time.Now().After(entry.expires) {
-		_ = c.Delete(ctx, key)
-		return nil, ErrCacheMiss
+		_, _ = c.Delete(ctx, key)
+		return nil, errCacheExpired
 	}
 
-	return &entry.value, nil
+	value := new(T)
+	if err := c.codec.Unmarshal(entry.value, value); err != nil {
+		return nil, err
+	}
+
+	return value, nil
+}
+
+func (c *inMemoryCacheImpl[T]) BulkGet(ctx context.Context, keys []string) ([]*T, error) {
+	values := make([]*T, len(keys))
+	for i, key := range keys {
+		value, err := c.Get(ctx, key)
+		if errors.Is(err, ErrCacheMiss) {
+			continue
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		values[i] = value
+	}
+
+	return values, nil
 }
 
 func (c *inMemoryCacheImpl[T]) Set(ctx context.Context, key string, value T, opts *CacheOpts) error {
-	entry := inMemoryCacheEntry[T]{
-		value: value,
+	data, err := c.codec.Marshal(value)
+	if err != nil {
+		return err
+	}
+
+	entry := inMemoryCacheEntry{
+		value: data,
 	}
 
 	if opts != nil && opts.TTL != nil {
@@ -169,53 +669,115 @@ func (c *inMemoryCacheImpl[T]) Set(ctx context.Context, key string, value T, opt
 
 	c.mu.Lock()
 	c.entries[key] = entry
+	c.sweep(1)
 	c.mu.Unlock()
 
 	return nil
 }
 
+// sweep will count n writes, deleting every expired entry once enough writes
+// have been made since the last sweep. The caller must hold the write lock.
+func (c *inMemoryCacheImpl[T]) sweep(n int) {
+	c.writes += n
+	if c.writes < inMemorySweepInterval {
+		return
+	}
+
+	c.writes = 0
+
+	now := time.Now()
+	maps.DeleteFunc(c.entries, func(key string, entry inMemoryCacheEntry) bool {
+		return !entry.expires.IsZero() && now.After(entry.expires)
+	})
+}
+
 func (c *inMemoryCacheImpl[T]) BulkSet(ctx context.Context, keyValues map[string]T, opts *CacheOpts) error {
 	var expiresAt time.Time
 	if opts != nil && opts.TTL != nil {
 		expiresAt = time.Now().Add(*opts.TTL)
 	}
 
-	c.mu.Lock()
-	defer c.mu.Unlock()
-
+	entries := make(map[string]inMemoryCacheEntry, len(keyValues))
 	for k, v := range keyValues {
-		entry := inMemoryCacheEntry[T]{
-			value:   v,
-			expires: expiresAt,
+		data, err := c.codec.Marshal(v)
+		if err != nil {
+			return err
 		}
 
-		c.entries[k] = entry
+		entries[k] = inMemoryCacheEntry{
+			value:   data,
+			expires: expiresAt,
+		}
 	}
 
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	maps.Copy(c.entries, entries)
+	c.sweep(len(entries))
+
 	return nil
 }
 
-func (c *inMemoryCacheImpl[T]) Delete(ctx context.Context, key string) error {
+func (c *inMemoryCacheImpl[T]) Delete(ctx context.Context, key string) (int, error) {
 	c.mu.Lock()
 	defer c.mu.Unlock()
 
+	if _, ok := c.entries[key]; !ok {
+		return 0, nil
+	}
+
 	delete(c.entries, key)
 
-	return nil
+	return 1, nil
+}
+
+func (c *inMemoryCacheImpl[T]) DeletePrefix(ctx context.Context, prefix string) (int, error) {
+	return c.DeleteFunc(ctx, prefix, func(string) bool { return true })
+}
+
+func (c *inMemoryCacheImpl[T]) DeleteFunc(ctx context.Context, prefix string, del func(key string) bool) (int, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	deleted := 0
+	for key := range c.entries {
+		if strings.HasPrefix(key, prefix) && del(key) {
+			delete(c.entries, key)
+			deleted++
+		}
+	}
+
+	return deleted, nil
 }
 
 type DefaultCache struct {
 	anime AnimeCache
+	lists ListCache
+	watch WatchStateCache
 }
 
 // DefaultCache is a cache manager for an in-memory cache.
-func NewCache() Caches {
+//
+// Values are stored encoded with the configured codec, JSON by default.
+func NewCache(opts ...CacheOption) Caches {
+	cfg := newCacheConfig(opts)
+
 	return &DefaultCache{
 		anime: newAnimeCache(
-			newInMemoryCache[Anime](),
-			newInMemoryCache[AnimeFull](),
-			newInMemoryCache[Episode](),
+			cfg,
+			newInMemoryCache[Anime](cfg.codec),
+			newInMemoryCache[AnimeFull](cfg.codec),
+			newInMemoryCache[Episode](cfg.codec),
+			newInMemoryCache[notFound](cfg.codec),
 		),
+		lists: newListCache(
+			cfg,
+			newInMemoryCache[PaginatedResponseBody[Anime]](cfg.codec),
+			newInMemoryCache[PaginatedResponseBody[Episode]](cfg.codec),
+			newInMemoryCache[PaginatedResponseBody[Season]](cfg.codec),
+		),
+		watch: newWatchStateCache(cfg, newInMemoryCache[WatchState](JSONCodec)),
 	}
 }
 
@@ -223,96 +785,69 @@ func (c *DefaultCache) Anime() AnimeCache {
 	return c.anime
 }
 
-type redisJSONCacheImpl[T any] struct {
-	sf     singleflight.Group
-	client *redis.Client
+func (c *DefaultCache) Lists() ListCache {
+	return c.lists
 }
 
-// NewRedisCache will create a new cache manager for Redis that implements the Cache interface.
-func newRedisJSONCache[T any](client *redis.Client) baseCache[T] {
-	return &redisJSONCacheImpl[T]{client: client}
+func (c *DefaultCache) Watch() WatchStateCache {
+	return c.watch
 }
 
-func (c *redisJSONCacheImpl[T]) Get(ctx context.Context, key string) (*T, error) {
-	result, err, _ := c.sf.Do(key, func() (any, error) {
-		var v T
-		if err := redisx.JSONUnwrap(ctx, c.client, key, "$", &v); err != nil {
-			return nil, err
-		}
-
-		return v, nil
-	})
-
+func (c *DefaultCache) InvalidateAnime(ctx context.Context, id string) error {
+	animeID, err := ParseAnimeID(id)
 	if err != nil {
-		return nil, err
-	}
-
-	value, ok := result.(T)
-	if !ok {
-		return nil, nil
+		return err
 	}
 
-	return &value, nil
+	return c.InvalidateAnimeByMalID(ctx, animeID)
 }
 
-func (c *redisJSONCacheImpl[T]) Set(ctx context.Context, key string, value T, opts *CacheOpts) error {
-	pipeline := c.client.Pipeline()
-
-	pipeline.JSONSet(ctx, key, "$", value)
-
-	if opts != nil && opts.TTL != nil {
-		pipeline.Expire(ctx, key, *opts.TTL)
-	}
-
-	_, err := pipeline.Exec(ctx)
-	return err
+func (c *DefaultCache) InvalidateAnimeByMalID(ctx context.Context, id AnimeID) error {
+	return errors.Join(
+		c.anime.InvalidateAnimeByMalID(ctx, id),
+		c.lists.InvalidateEpisodeListsByMalID(ctx, id),
+	)
 }
 
-func (c *redisJSONCacheImpl[T]) BulkSet(ctx context.Context, keyValues map[string]T, opts *CacheOpts) error {
-	pipeline := c.client.Pipeline()
-
-	for key, value := range keyValues {
-		pipeline.JSONSet(ctx, key, "$", value)
-
-		if opts != nil && opts.TTL != nil {
-			pipeline.Expire(ctx, key, *opts.TTL)
-		}
-	}
-
-	_, err := pipeline.Exec(ctx)
-	return err
-}
-
-func (c *redisJSONCacheImpl[T]) Delete(ctx context.Context, key string) error {
-	return c.client.JSONDel(ctx, key, "$").Err()
+func (c *DefaultCache) Purge(ctx context.Context, prefix string) error {
+	return errors.Join(
+		c.anime.Purge(ctx, prefix),
+		c.lists.Purge(ctx, prefix),
+		c.watch.Purge(ctx, prefix),
+	)
 }
 
-type Caches interface {
-	Anime() AnimeCache
+// FlushAll will delete every entry, an in-memory cache only ever holds keys of
+// the current schema version.
+func (c *DefaultCache) FlushAll(ctx context.Context) error {
+	return errors.Join(c.Purge(ctx, ""), c.watch.Purge(ctx, "watch:"))
 }
 
-type RedisJSONCache struct {
-	anime AnimeCache
-}
+func (c *DefaultCache) Stats() map[string]CacheStats {
+	stats := c.anime.Stats()
+	maps.Copy(stats, c.lists.Stats())
+	maps.Copy(stats, c.watch.Stats())
 
-// RedisJSONCache is a cache manager for Redis.
-//
-// This will only use Redis' JSON commands, so using this will require your Redis
-// instance to have the JSON module loaded. This is available on the redis-stack
-// builds.
-//
-// When setting up your redis.conf, all you need to do is add this line:
-// `loadmodule /opt/redis-stack/lib/rejson.so`
-func NewRedisJSONCache(client *redis.Client) Caches {
-	return &RedisJSONCache{
-		anime: newAnimeCache(
-			newRedisJSONCache[Anime](client),
-			newRedisJSONCache[AnimeFull](client),
-			newRedisJSONCache[Episode](client),
-		),
-	}
+	return stats
 }
 
-func (c *RedisJSONCache) Anime() AnimeCache {
-	return c.anime
+type Caches interface {
+	Anime() AnimeCache
+	Lists() ListCache
+	Watch() WatchStateCache
+
+	// InvalidateAnime will delete everything cached for an anime, including its
+	// episodes and episode list pages.
+	InvalidateAnime(ctx context.Context, id string) error
+	InvalidateAnimeByMalID(ctx context.Context, id AnimeID) error
+	// Purge will delete every entry whose key starts with prefix. The prefix is
+	// relative to the key namespace and schema version, e.g. "list:". Watch
+	// state is unversioned and only purged by a prefix such as "watch:".
+	Purge(ctx context.Context, prefix string) error
+	// FlushAll will delete every entry under the key prefix, including those of
+	// older versions. Other keys, including those of longer prefixes, are left alone.
+	FlushAll(ctx context.Context) error
+
+	// Stats will return the statistics of every cached resource type, keyed by resource.
+	Stats() map[string]CacheStats
 }
@@ -0,0 +1,239 @@
+package jikan
+
+import (
+	"compress/gzip"
+	"context"
+	"errors"
+	"strconv"
+	"testing"
+	"time"
+)
+
+func TestAnimeNotFoundCache(t *testing.T) {
+	cache := NewCache().Anime()
+
+	if err := cache.SetAnimeNotFound(t.Context(), "1"); err != nil {
+		t.Fatal(err)
+	}
+
+	_, err := cache.GetAnimeFull(t.Context(), "1")
+	if !errors.Is(err, ErrNotFound) {
+		t.Fatalf("expected ErrNotFound, got %v", err)
+	}
+
+	var nf *NotFoundError
+	if !errors.As(err, &nf) || nf.Resource != "anime" || nf.ID != "1" {
+		t.Fatalf("expected *NotFoundError for anime 1, got %#v", err)
+	}
+
+	if _, err := cache.GetAnime(t.Context(), "2"); !errors.Is(err, ErrCacheMiss) {
+		t.Fatalf("expected ErrCacheMiss, got %v", err)
+	}
+}
+
+func TestAnimeNotFoundCacheDisabled(t *testing.T) {
+	cache := NewCache(WithNotFoundTTL(0)).Anime()
+
+	if err := cache.SetEpisodeNotFound(t.Context(), "1", 2); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := cache.GetEpisode(t.Context(), "1", 2); !errors.Is(err, ErrCacheMiss) {
+		t.Fatalf("expected ErrCacheMiss, got %v", err)
+	}
+}
+
+func TestCacheStats(t *testing.T) {
+	var events []CacheEvent
+	hook := CacheHookFunc(func(ctx context.Context, event CacheEvent) {
+		events = append(events, event)
+	})
+
+	cache := NewCache(WithCacheHook(hook))
+
+	if err := cache.Anime().SetAnime(t.Context(), Anime{MalID: 1}); err != nil {
+		t.Fatal(err)
+	}
+
+	_, _ = cache.Anime().GetAnime(t.Context(), "1")
+	_, _ = cache.Anime().GetAnime(t.Context(), "2")
+
+	stats := cache.Stats()["anime"]
+	if stats.Hits != 1 || stats.Misses != 1 || stats.Operations != 3 {
+		t.Fatalf("unexpected anime stats: %+v", stats)
+	}
+
+	if stats.HitRatio() != 0.5 {
+		t.Fatalf("expected hit ratio of 0.5, got %f", stats.HitRatio())
+	}
+
+	// Deleting a missing key is not an eviction.
+	_ = cache.Anime().InvalidateAnime(t.Context(), "2")
+	_ = cache.Anime().InvalidateAnime(t.Context(), "1")
+
+	if evictions := cache.Stats()["anime"].Evictions; evictions != 1 {
+		t.Fatalf("expected 1 eviction, got %d", evictions)
+	}
+
+	if len(events) == 0 || events[0].Resource != "anime" || events[0].Op != CacheOpSet {
+		t.Fatalf("unexpected events: %+v", events)
+	}
+}
+
+func TestInvalidateAnime(t *testing.T) {
+	cache := NewCache()
+	ctx := t.Context()
+
+	_ = cache.Anime().SetAnimeFull(ctx, AnimeFull{Anime: Anime{MalID: 1}})
+	_ = cache.Anime().SetAnime(ctx, Anime{MalID: 10})
+	_ = cache.Anime().BulkSetEpisodes(ctx, "1", []Episode{{MalID: 1}, {MalID: 2}})
+	_ = cache.Lists().SetEpisodeList(ctx, "/v4/anime/1/episodes?page=2", PaginatedResponseBody[Episode]{}, time.Hour)
+
+	if err := cache.InvalidateAnime(ctx, "1"); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := cache.Anime().GetAnime(ctx, "1"); !errors.Is(err, ErrCacheMiss) {
+		t.Fatalf("expected anime to be invalidated, got %v", err)
+	}
+
+	if _, err := cache.Anime().GetAnimeFull(ctx, "1"); !errors.Is(err, ErrCacheMiss) {
+		t.Fatalf("expected full anime to be invalidated, got %v", err)
+	}
+
+	if _, err := cache.Anime().GetEpisode(ctx, "1", 2); !errors.Is(err, ErrCacheMiss) {
+		t.Fatalf("expected episode to be invalidated, got %v", err)
+	}
+
+	if _, err := cache.Lists().GetEpisodeList(ctx, "/v4/anime/1/episodes?page=2"); !errors.Is(err, ErrCacheMiss) {
+		t.Fatalf("expected episode list to be invalidated, got %v", err)
+	}
+
+	if _, err := cache.Anime().GetAnime(ctx, "10"); err != nil {
+		t.Fatalf("expected anime 10 to be kept, got %v", err)
+	}
+
+	if err := cache.FlushAll(ctx); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := cache.Anime().GetAnime(ctx, "10"); !errors.Is(err, ErrCacheMiss) {
+		t.Fatalf("expected anime 10 to be flushed, got %v", err)
+	}
+}
+
+func TestAnimeCacheInvalidID(t *testing.T) {
+	cache := NewCache()
+	ctx := t.Context()
+
+	_ = cache.Anime().SetAnime(ctx, Anime{MalID: 1})
+
+	if _, err := cache.Anime().GetAnime(ctx, "abc"); !errors.Is(err, ErrInvalidQuery) {
+		t.Fatalf("expected ErrInvalidQuery, got %v", err)
+	}
+
+	if err := cache.InvalidateAnime(ctx, "-1"); !errors.Is(err, ErrInvalidQuery) {
+		t.Fatalf("expected ErrInvalidQuery, got %v", err)
+	}
+
+	if _, err := cache.Anime().GetAnimeByMalID(ctx, 1); err != nil {
+		t.Fatalf("expected anime 1 to be cached, got %v", err)
+	}
+}
+
+func TestKeyPrefix(t *testing.T) {
+	cfg := newCacheConfig([]CacheOption{WithKeyPrefix("staging:")})
+
+	if key := cfg.keys.anime(1); key != "staging:"+keyVersion(JSONCodec)+":anime:1" {
+		t.Fatalf("unexpected key: %q", key)
+	}
+
+	if pattern := cfg.keys.allVersions(); pattern != "staging:s[0-9a-f][0-9a-f][0-9a-f][0-9a-f][0-9a-f][0-9a-f][0-9a-f][0-9a-f]:*" {
+		t.Fatalf("unexpected pattern: %q", pattern)
+	}
+
+	gob := newCacheConfig([]CacheOption{WithKeyPrefix("staging"), WithCodec(GobCodec)})
+	if gob.keys.current() == cfg.keys.current() {
+		t.Fatal("expected the codec to change the key version")
+	}
+
+	redisJSON := NewRedisJSONCache(nil, WithKeyPrefix("staging"), WithCodec(GobCodec)).(*RedisJSONCache)
+	if redisJSON.keys.current() != cfg.keys.current() {
+		t.Fatal("expected the codec to leave the RedisJSON key version alone")
+	}
+
+	if empty := newCacheConfig([]CacheOption{WithKeyPrefix(":")}); empty.keys.prefix != defaultKeyPrefix {
+		t.Fatalf("expected an empty prefix to fall back to %q, got %q", defaultKeyPrefix, empty.keys.prefix)
+	}
+}
+
+func TestInMemoryCacheReturnsCopies(t *testing.T) {
+	for name, codec := range map[string]Codec{
+		"json":      JSONCodec,
+		"gob":       GobCodec,
+		"gzip+json": NewGzipCodec(JSONCodec, gzip.BestSpeed),
+		"gzip+gob":  NewGzipCodec(GobCodec, gzip.BestSpeed),
+	} {
+		t.Run(name, func(t *testing.T) {
+			cache := NewCache(WithCodec(codec)).Anime()
+			ctx := t.Context()
+
+			anime := Anime{
+				MalID:  1,
+				Genres: []Entity{{MalID: 1, Name: "Action"}},
+				// Pointers to zero values must not come back as nil.
+				Score: new(0.0),
+				Rank:  new(0),
+				Aired: AiredInfo{Prop: AiredProp{From: DateProp{Day: new(0), Year: new(2024)}}},
+			}
+			if err := cache.SetAnime(ctx, anime); err != nil {
+				t.Fatal(err)
+			}
+
+			anime.Genres[0].Name = "Mutated"
+
+			first, err := cache.GetAnime(ctx, "1")
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			first.Genres = append(first.Genres, Entity{MalID: 2, Name: "Comedy"})
+
+			second, err := cache.GetAnime(ctx, "1")
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if len(second.Genres) != 1 || second.Genres[0].Name != "Action" {
+				t.Fatalf("cached value was mutated: %+v", second.Genres)
+			}
+
+			if second.Score == nil || second.Rank == nil || second.Aired.Prop.From.Day == nil || second.Members != nil {
+				t.Fatalf("pointer presence was not kept: score %v, rank %v, day %v, members %v",
+					second.Score, second.Rank, second.Aired.Prop.From.Day, second.Members)
+			}
+		})
+	}
+}
+
+func TestInMemoryCacheSweep(t *testing.T) {
+	cache := newInMemoryCache[Anime](JSONCodec).(*inMemoryCacheImpl[Anime])
+	ctx := t.Context()
+
+	if err := cache.Set(ctx, "expired", Anime{}, &CacheOpts{TTL: new(-time.Second)}); err != nil {
+		t.Fatal(err)
+	}
+
+	entries := make(map[string]Anime, inMemorySweepInterval)
+	for i := range inMemorySweepInterval {
+		entries[strconv.Itoa(i)] = Anime{MalID: i}
+	}
+
+	if err := cache.BulkSet(ctx, entries, nil); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, ok := cache.entries["expired"]; ok || len(cache.entries) != inMemorySweepInterval {
+		t.Fatalf("expected the expired entry to be swept, got %d entries", len(cache.entries))
+	}
+}
@@ -0,0 +1,82 @@
+package jikan
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/minnasync/jikan-go/metrics"
+)
+
+// clientMetrics are the metrics recorded by a client with WithMetrics.
+type clientMetrics struct {
+	requests     metrics.Counter
+	latency      metrics.Histogram
+	limiterWait  metrics.Histogram
+	cacheLookups metrics.Counter
+}
+
+// WithMetrics will record metrics for every endpoint call in registry:
+//
+//   - jikan_requests_total, requests sent to Jikan by operation and status.
+//     The status is "error" when no response was received.
+//   - jikan_request_duration_seconds, request latency by operation, including
+//     the time spent waiting on the rate limiter.
+//   - jikan_limiter_wait_seconds, time spent waiting on the rate limiter by operation.
+//   - jikan_cache_lookups_total, endpoint cache lookups by operation and result,
+//     one of "hit", "miss" or "bypass" for ForceRefresh.
+//
+// Use metrics.NewRegistry for a registry that serves the Prometheus text format.
+func WithMetrics(registry metrics.Registry) ClientOption {
+	return func(c *Client) {
+		c.metrics = &clientMetrics{
+			requests: registry.Counter("jikan_requests_total",
+				"Requests sent to Jikan.", "operation", "status"),
+			latency: registry.Histogram("jikan_request_duration_seconds",
+				"Latency of requests sent to Jikan, including the rate limiter wait.", metrics.DefaultBuckets, "operation"),
+			limiterWait: registry.Histogram("jikan_limiter_wait_seconds",
+				"Time requests spent waiting on the rate limiter.", metrics.DefaultBuckets, "operation"),
+			cacheLookups: registry.Counter("jikan_cache_lookups_total",
+				"Endpoint cache lookups.", "operation", "result"),
+		}
+	}
+}
+
+// observeCacheHit will record a response served from the cache.
+func (c *Client) observeCacheHit(ctx context.Context, path string) {
+	c.logCacheHit(ctx, path)
+
+	if c.metrics != nil {
+		operation, _ := OperationFromContext(ctx)
+		c.metrics.cacheLookups.Add(1, operation, "hit")
+	}
+}
+
+// observeRequest will record a request sent to Jikan.
+func (c *Client) observeRequest(ctx context.Context, req *http.Request, resp *http.Response, latency, wait time.Duration, err error) {
+	c.logRequest(ctx, req, resp, latency, wait, err)
+
+	if c.metrics == nil {
+		return
+	}
+
+	operation, ok := OperationFromContext(ctx)
+	if ok {
+		result := "miss"
+		if !c.readCache(ctx) {
+			result = "bypass"
+		}
+
+		c.metrics.cacheLookups.Add(1, operation, result)
+	}
+
+	status := "error"
+	if resp != nil {
+		status = strconv.Itoa(resp.StatusCode)
+	}
+
+	c.metrics.requests.Add(1, operation, status)
+	c.metrics.latency.Observe(latency.Seconds(), operation)
+	c.metrics.limiterWait.Observe(wait.Seconds(), operation)
+}
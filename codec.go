@@ -0,0 +1,223 @@
+package jikan
+
+import (
+	"bytes"
+	"compress/gzip"
+	"encoding/gob"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"reflect"
+	"slices"
+)
+
+// Codec serializes cache values for backends that store bytes.
+//
+// Other encodings, such as msgpack or zstd compression, can be used by
+// implementing this interface. Keys include the codec, named by its String
+// method when it implements fmt.Stringer, so switching codecs never decodes
+// values written by another one.
+type Codec interface {
+	Marshal(v any) ([]byte, error)
+	Unmarshal(data []byte, v any) error
+}
+
+var (
+	// JSONCodec encodes values as JSON, it is the default codec.
+	JSONCodec Codec = jsonCodec{}
+	// GobCodec encodes values with encoding/gob. Every value is encoded on its
+	// own, so each entry repeats its type descriptors. Gob flattens pointers, so
+	// the pointers to zero values, such as a score of 0, are recorded next to
+	// the value and restored on decode.
+	GobCodec Codec = gobCodec{}
+)
+
+// WithCodec will set the codec used by byte-oriented cache backends.
+//
+// This has no effect on NewRedisJSONCache, which always stores values as RedisJSON documents.
+func WithCodec(codec Codec) CacheOption {
+	return func(c *cacheConfig) {
+		c.codec = codec
+	}
+}
+
+// codecName will identify codec in the key version, codecs that implement
+// fmt.Stringer can name themselves.
+func codecName(codec Codec) string {
+	if s, ok := codec.(fmt.Stringer); ok {
+		return s.String()
+	}
+
+	return fmt.Sprintf("%T", codec)
+}
+
+type jsonCodec struct{}
+
+func (jsonCodec) String() string {
+	return "json"
+}
+
+func (jsonCodec) Marshal(v any) ([]byte, error) {
+	return json.Marshal(v)
+}
+
+func (jsonCodec) Unmarshal(data []byte, v any) error {
+	return json.Unmarshal(data, v)
+}
+
+type gobCodec struct{}
+
+func (gobCodec) String() string {
+	return "gob"
+}
+
+func (gobCodec) Marshal(v any) ([]byte, error) {
+	var buf bytes.Buffer
+	enc := gob.NewEncoder(&buf)
+	if err := enc.Encode(v); err != nil {
+		return nil, err
+	}
+
+	var zeros [][]int
+	findZeroPointers(reflect.Indirect(reflect.ValueOf(v)), nil, &zeros)
+	if len(zeros) > 0 {
+		if err := enc.Encode(zeros); err != nil {
+			return nil, err
+		}
+	}
+
+	return buf.Bytes(), nil
+}
+
+func (gobCodec) Unmarshal(data []byte, v any) error {
+	dec := gob.NewDecoder(bytes.NewReader(data))
+	if err := dec.Decode(v); err != nil {
+		return err
+	}
+
+	var zeros [][]int
+	if err := dec.Decode(&zeros); err != nil && !errors.Is(err, io.EOF) {
+		return err
+	}
+
+	root := reflect.ValueOf(v).Elem()
+	for _, path := range zeros {
+		restoreZeroPointer(root, path)
+	}
+
+	return nil
+}
+
+// findZeroPointers will record the path of every non-nil pointer to a zero value
+// in v, as struct field and slice indexes. Map values are not addressable and
+// are skipped.
+func findZeroPointers(v reflect.Value, path []int, zeros *[][]int) {
+	switch v.Kind() {
+	case reflect.Pointer:
+		if v.IsNil() {
+			return
+		}
+
+		if v.Elem().IsZero() {
+			*zeros = append(*zeros, slices.Clone(path))
+			return
+		}
+
+		findZeroPointers(v.Elem(), path, zeros)
+	case reflect.Struct:
+		for i := range v.NumField() {
+			if v.Type().Field(i).IsExported() {
+				findZeroPointers(v.Field(i), append(path, i), zeros)
+			}
+		}
+	case reflect.Slice, reflect.Array:
+		for i := range v.Len() {
+			findZeroPointers(v.Index(i), append(path, i), zeros)
+		}
+	}
+}
+
+// restoreZeroPointer will point the nil pointer at path to a new zero value.
+func restoreZeroPointer(v reflect.Value, path []int) {
+	for _, i := range path {
+		for v.Kind() == reflect.Pointer && !v.IsNil() {
+			v = v.Elem()
+		}
+
+		switch v.Kind() {
+		case reflect.Struct:
+			if i >= v.NumField() {
+				return
+			}
+
+			v = v.Field(i)
+		case reflect.Slice, reflect.Array:
+			if i >= v.Len() {
+				return
+			}
+
+			v = v.Index(i)
+		default:
+			return
+		}
+	}
+
+	if v.Kind() == reflect.Pointer && v.IsNil() {
+		v.Set(reflect.New(v.Type().Elem()))
+	}
+}
+
+type gzipCodec struct {
+	codec Codec
+	level int
+}
+
+// NewGzipCodec will wrap codec, compressing its output with gzip.
+//
+// Full anime records compress well, which trades some CPU for Redis memory.
+func NewGzipCodec(codec Codec, level int) Codec {
+	return &gzipCodec{codec: codec, level: level}
+}
+
+func (c *gzipCodec) String() string {
+	return "gzip+" + codecName(c.codec)
+}
+
+func (c *gzipCodec) Marshal(v any) ([]byte, error) {
+	data, err := c.codec.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+
+	var buf bytes.Buffer
+	w, err := gzip.NewWriterLevel(&buf, c.level)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := w.Write(data); err != nil {
+		return nil, err
+	}
+
+	if err := w.Close(); err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+func (c *gzipCodec) Unmarshal(data []byte, v any) error {
+	r, err := gzip.NewReader(bytes.NewReader(data))
+	if err != nil {
+		return err
+	}
+	defer r.Close()
+
+	decompressed, err := io.ReadAll(r)
+	if err != nil {
+		return err
+	}
+
+	return c.codec.Unmarshal(decompressed, v)
+}
@@ -0,0 +1,189 @@
+package jikan
+
+import (
+	"time"
+)
+
+// DatePrecision is how much of a date is known.
+type DatePrecision int
+
+const (
+	DatePrecisionNone DatePrecision = iota
+	DatePrecisionYear
+	DatePrecisionMonth
+	DatePrecisionDay
+)
+
+// PartialDate is a date that may only be known to the year or month, e.g. an anime
+// announced for "2025" or "Apr 2025".
+//
+// Time is the first instant of the known period in UTC, so "Apr 2025" is
+// 2025-04-01T00:00:00Z.
+type PartialDate struct {
+	Time      time.Time
+	Precision DatePrecision
+}
+
+// End will return the first instant after the known period, e.g. 2025-05-01 for "Apr 2025".
+func (d PartialDate) End() time.Time {
+	switch d.Precision {
+	case DatePrecisionYear:
+		return d.Time.AddDate(1, 0, 0)
+	case DatePrecisionMonth:
+		return d.Time.AddDate(0, 1, 0)
+	case DatePrecisionDay:
+		return d.Time.AddDate(0, 0, 1)
+	}
+
+	return d.Time
+}
+
+// Compare will order dates by time, a less precise date sorts before a more
+// precise one starting at the same time.
+func (d PartialDate) Compare(other PartialDate) int {
+	if c := d.Time.Compare(other.Time); c != 0 {
+		return c
+	}
+
+	return int(d.Precision) - int(other.Precision)
+}
+
+func (d PartialDate) String() string {
+	switch d.Precision {
+	case DatePrecisionYear:
+		return d.Time.Format("2006")
+	case DatePrecisionMonth:
+		return d.Time.Format("2006-01")
+	case DatePrecisionDay:
+		return d.Time.Format("2006-01-02")
+	}
+
+	return ""
+}
+
+// DateProp holds the components Jikan sends for a date, any of them can be null.
+type DateProp struct {
+	Day   *int `json:"day"`
+	Month *int `json:"month"`
+	Year  *int `json:"year"`
+}
+
+// Date will build a PartialDate from the known components.
+func (p DateProp) Date() (PartialDate, bool) {
+	if p.Year == nil || *p.Year <= 0 {
+		return PartialDate{}, false
+	}
+
+	if p.Month == nil || *p.Month < 1 || *p.Month > 12 {
+		return PartialDate{
+			Time:      time.Date(*p.Year, time.January, 1, 0, 0, 0, 0, time.UTC),
+			Precision: DatePrecisionYear,
+		}, true
+	}
+
+	month := time.Month(*p.Month)
+	if p.Day == nil || *p.Day < 1 || *p.Day > 31 {
+		return PartialDate{
+			Time:      time.Date(*p.Year, month, 1, 0, 0, 0, 0, time.UTC),
+			Precision: DatePrecisionMonth,
+		}, true
+	}
+
+	return PartialDate{
+		Time:      time.Date(*p.Year, month, *p.Day, 0, 0, 0, 0, time.UTC),
+		Precision: DatePrecisionDay,
+	}, true
+}
+
+type AiredProp struct {
+	From DateProp `json:"from"`
+	To   DateProp `json:"to"`
+}
+
+// FromDate will return when airing started, preferring the date components
+// since From is filled in with the first of the month or year for partial dates.
+func (a AiredInfo) FromDate() (PartialDate, bool) {
+	return resolveDate(a.Prop.From, a.From)
+}
+
+// ToDate will return when airing ended.
+func (a AiredInfo) ToDate() (PartialDate, bool) {
+	return resolveDate(a.Prop.To, a.To)
+}
+
+func resolveDate(prop DateProp, raw string) (PartialDate, bool) {
+	if date, ok := prop.Date(); ok {
+		return date, true
+	}
+
+	t, ok := parseTimestamp(&raw)
+	if !ok {
+		return PartialDate{}, false
+	}
+
+	return PartialDate{Time: t.UTC(), Precision: DatePrecisionDay}, true
+}
+
+// parseTimestamp will parse the ISO 8601 timestamps Jikan uses.
+func parseTimestamp(raw *string) (time.Time, bool) {
+	if raw == nil || *raw == "" {
+		return time.Time{}, false
+	}
+
+	t, err := time.Parse(time.RFC3339, *raw)
+	if err != nil {
+		return time.Time{}, false
+	}
+
+	return t, true
+}
+
+// StartDate will return when the anime started or will start airing.
+func (a *Anime) StartDate() (PartialDate, bool) {
+	return a.Aired.FromDate()
+}
+
+// EndDate will return when the anime finished airing.
+func (a *Anime) EndDate() (PartialDate, bool) {
+	return a.Aired.ToDate()
+}
+
+// IsUpcoming will report whether the anime has not started airing at now.
+//
+// When now falls inside a partial start date, e.g. during April for "Apr 2025",
+// the airing status decides.
+func (a *Anime) IsUpcoming(now time.Time) bool {
+	start, ok := a.StartDate()
+	if ok && now.Before(start.Time) {
+		return true
+	}
+
+	if ok && start.Precision == DatePrecisionDay {
+		return false
+	}
+
+	if ok && !now.Before(start.End()) {
+		return false
+	}
+
+	return a.Status != nil && *a.Status == AnimeStatusUpcoming
+}
+
+// AiredAt will return when the episode aired.
+func (e *Episode) AiredAt() (time.Time, bool) {
+	return parseTimestamp(e.Aired)
+}
+
+// PremiereYear will return the year the anime premiered in, from Year or the start date.
+func (a *Anime) PremiereYear() (int, bool) {
+	if a.Year != nil && *a.Year > 0 {
+		return *a.Year, true
+	}
+
+	start, ok := a.StartDate()
+	if !ok {
+		return 0, false
+	}
+
+	return start.Time.Year(), true
+}
@@ -0,0 +1,54 @@
+package jikan
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+)
+
+func TestAiredDates(t *testing.T) {
+	var anime Anime
+	err := json.Unmarshal([]byte(`{
+		"status": "Not yet aired",
+		"aired": {
+			"from": "2025-04-01T00:00:00+00:00",
+			"to": null,
+			"prop": {
+				"from": {"day": null, "month": 4, "year": 2025},
+				"to": {"day": null, "month": null, "year": null}
+			},
+			"string": "Apr 2025 to ?"
+		}
+	}`), &anime)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	start, ok := anime.StartDate()
+	if !ok || start.Precision != DatePrecisionMonth || start.String() != "2025-04" {
+		t.Fatalf("unexpected start date: %+v", start)
+	}
+
+	if _, ok := anime.EndDate(); ok {
+		t.Fatal("expected no end date")
+	}
+
+	for now, expected := range map[time.Time]bool{
+		time.Date(2025, time.March, 31, 0, 0, 0, 0, time.UTC): true,
+		time.Date(2025, time.April, 15, 0, 0, 0, 0, time.UTC): true,
+		time.Date(2025, time.May, 1, 0, 0, 0, 0, time.UTC):    false,
+	} {
+		if anime.IsUpcoming(now) != expected {
+			t.Fatalf("IsUpcoming(%s) = %v", now, !expected)
+		}
+	}
+}
+
+func TestAiredDatesFallback(t *testing.T) {
+	aired := AiredInfo{From: "1998-04-03T00:00:00+00:00"}
+
+	from, ok := aired.FromDate()
+	if !ok || from.Precision != DatePrecisionDay || from.String() != "1998-04-03" {
+		t.Fatalf("unexpected from date: %+v", from)
+	}
+}
@@ -0,0 +1,326 @@
+package jikan
+
+import (
+	"context"
+	"maps"
+	"slices"
+	"strings"
+	"time"
+)
+
+// Fields reported in a FieldChange.
+const (
+	DiffFieldTitle         = "title"
+	DiffFieldTitleJapanese = "title_japanese"
+	DiffFieldTitleRomanji  = "title_romanji"
+	DiffFieldStatus        = "status"
+	DiffFieldAiring        = "airing"
+	DiffFieldAired         = "aired"
+	DiffFieldBroadcast     = "broadcast"
+	DiffFieldEpisodes      = "episodes"
+	DiffFieldDuration      = "duration"
+	DiffFieldScore         = "score"
+	DiffFieldRank          = "rank"
+	DiffFieldFiller        = "filler"
+	DiffFieldRecap         = "recap"
+)
+
+// FieldChange is a single changed field. Old and New hold the dereferenced
+// values, nil when the field was not set.
+type FieldChange struct {
+	Field string
+	Old   any
+	New   any
+}
+
+// Diff is the difference between two fetches of the same resource.
+type Diff struct {
+	// Resource is "anime", "anime-full", "episode" or "episode-list".
+	Resource string
+	ID       AnimeID
+	// Episode is the episode number for episode diffs.
+	Episode int
+
+	Changes []FieldChange
+
+	// NewEpisodes are the episodes of an episode list that were not there before.
+	NewEpisodes []Episode
+	// Episodes are the changes to episodes present in both lists.
+	Episodes []Diff
+
+	// NewStreaming and RemovedStreaming are the changed streaming links of a full anime.
+	NewStreaming     []Link
+	RemovedStreaming []Link
+}
+
+// IsEmpty will check if nothing changed.
+func (d Diff) IsEmpty() bool {
+	return len(d.Changes) == 0 && len(d.NewEpisodes) == 0 && len(d.Episodes) == 0 &&
+		len(d.NewStreaming) == 0 && len(d.RemovedStreaming) == 0
+}
+
+// Change will return the change of a field, e.g. DiffFieldStatus.
+func (d Diff) Change(field string) (FieldChange, bool) {
+	i := slices.IndexFunc(d.Changes, func(c FieldChange) bool { return c.Field == field })
+	if i < 0 {
+		return FieldChange{}, false
+	}
+
+	return d.Changes[i], true
+}
+
+func (d *Diff) compare(field string, old, new any) {
+	if old != new {
+		d.Changes = append(d.Changes, FieldChange{Field: field, Old: old, New: new})
+	}
+}
+
+// deref will turn a nil pointer into a nil interface so changes compare by value.
+func deref[T any](v *T) any {
+	if v == nil {
+		return nil
+	}
+
+	return *v
+}
+
+// DiffAnime will compare two fetches of an anime.
+func DiffAnime(old, new *Anime) Diff {
+	d := Diff{Resource: "anime", ID: AnimeID(new.MalID)}
+	d.diffAnime(old, new)
+
+	return d
+}
+
+func (d *Diff) diffAnime(old, new *Anime) {
+	d.compare(DiffFieldTitle, old.Title, new.Title)
+	d.compare(DiffFieldStatus, deref(old.Status), deref(new.Status))
+	d.compare(DiffFieldAiring, old.Airing, new.Airing)
+	d.compare(DiffFieldAired, old.Aired.Text, new.Aired.Text)
+	d.compare(DiffFieldBroadcast, old.Broadcast.Text, new.Broadcast.Text)
+	d.compare(DiffFieldEpisodes, deref(old.Episodes), deref(new.Episodes))
+	d.compare(DiffFieldScore, deref(old.Score), deref(new.Score))
+	d.compare(DiffFieldRank, deref(old.Rank), deref(new.Rank))
+}
+
+// DiffAnimeFull will compare two fetches of a full anime, including its streaming links.
+func DiffAnimeFull(old, new *AnimeFull) Diff {
+	d := Diff{Resource: "anime-full", ID: AnimeID(new.MalID)}
+	d.diffAnime(&old.Anime, &new.Anime)
+
+	for _, link := range new.Streaming {
+		if !slices.Contains(old.Streaming, link) {
+			d.NewStreaming = append(d.NewStreaming, link)
+		}
+	}
+
+	for _, link := range old.Streaming {
+		if !slices.Contains(new.Streaming, link) {
+			d.RemovedStreaming = append(d.RemovedStreaming, link)
+		}
+	}
+
+	return d
+}
+
+// DiffEpisode will compare two fetches of an episode of anime id.
+func DiffEpisode(id AnimeID, old, new *Episode) Diff {
+	d := Diff{Resource: "episode", ID: id, Episode: new.MalID}
+	d.compare(DiffFieldTitle, old.Title, new.Title)
+	d.compare(DiffFieldTitleJapanese, deref(old.TitleJapanese), deref(new.TitleJapanese))
+	d.compare(DiffFieldTitleRomanji, deref(old.TitleRomanji), deref(new.TitleRomanji))
+	d.compare(DiffFieldAired, deref(old.Aired), deref(new.Aired))
+	d.compare(DiffFieldDuration, deref(old.Duration), deref(new.Duration))
+	d.compare(DiffFieldScore, deref(old.Score), deref(new.Score))
+	d.compare(DiffFieldFiller, old.Filler, new.Filler)
+	d.compare(DiffFieldRecap, old.Recap, new.Recap)
+
+	return d
+}
+
+// DiffEpisodes will compare two fetches of the episodes of anime id, reporting new
+// episodes and changes to the ones in both.
+func DiffEpisodes(id AnimeID, old, new []Episode) Diff {
+	d := Diff{Resource: "episode-list", ID: id}
+
+	for i := range new {
+		j := slices.IndexFunc(old, func(e Episode) bool { return e.MalID == new[i].MalID })
+		if j < 0 {
+			d.NewEpisodes = append(d.NewEpisodes, new[i])
+			continue
+		}
+
+		if episode := DiffEpisode(id, &old[j], &new[i]); !episode.IsEmpty() {
+			d.Episodes = append(d.Episodes, episode)
+		}
+	}
+
+	return d
+}
+
+// ChangeHook receives the differences when a cached anime, episode or episode
+// page is replaced by a different value, including anime written in bulk from
+// search and season lists. Episodes fetched as a page are reported once, in the
+// episode-list Diff of the page.
+//
+// Hooks are called synchronously after the new value is written, so
+// implementations should be cheap and must be safe for concurrent use.
+type ChangeHook interface {
+	OnChange(ctx context.Context, diff Diff)
+}
+
+// ChangeHookFunc is an adapter to allow the use of ordinary functions as a ChangeHook.
+type ChangeHookFunc func(ctx context.Context, diff Diff)
+
+func (f ChangeHookFunc) OnChange(ctx context.Context, diff Diff) {
+	f(ctx, diff)
+}
+
+// lastSeenTTL is how long the last written value is kept for comparison, well
+// past the TTL of the entries themselves so expired entries are still compared.
+const lastSeenTTL = time.Hour * 24 * 30
+
+// WithChangeHook will send the differences of replaced cache entries to hook.
+//
+// The last written value of every anime, episode and episode page is kept under
+// a separate key for 30 days, so a refetch after the entry expired is still
+// compared. This costs an extra cache lookup and write per entry, neither is
+// counted in Stats.
+//
+// Anime written in bulk from search, season and top pages are compared with
+// the cached entries in one lookup per page, without keeping a copy, so they
+// are only compared while cached.
+func WithChangeHook(hook ChangeHook) CacheOption {
+	return func(c *cacheConfig) {
+		c.changeHook = hook
+	}
+}
+
+// uninstrumented will return the cache without its statistics wrapper.
+func uninstrumented[T any](cache baseCache[T]) baseCache[T] {
+	if instrumented, ok := cache.(*instrumentedCache[T]); ok {
+		return instrumented.next
+	}
+
+	return cache
+}
+
+// peek will read a value without recording it in the cache statistics.
+func peek[T any](ctx context.Context, cache baseCache[T], key string) *T {
+	value, err := uninstrumented(cache).Get(ctx, key)
+	if err != nil {
+		return nil
+	}
+
+	return value
+}
+
+// peekMany will read many values without recording them in the cache statistics,
+// in one round trip when the backend supports it. Missing values are nil.
+func peekMany[T any](ctx context.Context, cache baseCache[T], keys []string) []*T {
+	cache = uninstrumented(cache)
+
+	if getter, ok := cache.(bulkGetter[T]); ok {
+		values, err := getter.BulkGet(ctx, keys)
+		if err != nil {
+			return make([]*T, len(keys))
+		}
+
+		return values
+	}
+
+	values := make([]*T, len(keys))
+	for i, key := range keys {
+		values[i] = peek(ctx, cache, key)
+	}
+
+	return values
+}
+
+// setAndDiff will set key to value, reporting the difference to the last value
+// written to key when a ChangeHook is configured.
+func setAndDiff[T any](
+	ctx context.Context,
+	cfg cacheConfig,
+	cache baseCache[T],
+	key string,
+	value T,
+	opts *CacheOpts,
+	diff func(old, new *T) Diff,
+) error {
+	if cfg.changeHook == nil {
+		return cache.Set(ctx, key, value, opts)
+	}
+
+	seenKey := cfg.keys.lastSeen(key)
+	old := peek(ctx, cache, seenKey)
+	if err := cache.Set(ctx, key, value, opts); err != nil {
+		return err
+	}
+
+	if err := uninstrumented(cache).Set(ctx, seenKey, value, &CacheOpts{TTL: new(lastSeenTTL)}); err != nil {
+		return err
+	}
+
+	if old == nil {
+		return nil
+	}
+
+	if d := diff(old, &value); !d.IsEmpty() {
+		cfg.changeHook.OnChange(ctx, d)
+	}
+
+	return nil
+}
+
+// bulkSetAndDiff is setAndDiff for BulkSet, comparing with the cached entries
+// rather than the last seen values. Differences are reported in key order.
+func bulkSetAndDiff[T any](
+	ctx context.Context,
+	cfg cacheConfig,
+	cache baseCache[T],
+	entries map[string]T,
+	opts *CacheOpts,
+	diff func(old, new *T) Diff,
+) error {
+	if cfg.changeHook == nil {
+		return cache.BulkSet(ctx, entries, opts)
+	}
+
+	keys := slices.Sorted(maps.Keys(entries))
+	old := peekMany(ctx, cache, keys)
+
+	if err := cache.BulkSet(ctx, entries, opts); err != nil {
+		return err
+	}
+
+	for i, key := range keys {
+		if old[i] == nil {
+			continue
+		}
+
+		value := entries[key]
+		if d := diff(old[i], &value); !d.IsEmpty() {
+			cfg.changeHook.OnChange(ctx, d)
+		}
+	}
+
+	return nil
+}
+
+// animeIDFromPath will return the anime ID of a request path such as
+// "/v4/anime/1/episodes?page=2".
+func animeIDFromPath(path string) AnimeID {
+	rest, ok := strings.CutPrefix(path, "/v4/anime/")
+	if !ok {
+		return 0
+	}
+
+	rest, _, _ = strings.Cut(rest, "/")
+	id, err := ParseAnimeID(rest)
+	if err != nil {
+		return 0
+	}
+
+	return id
+}
@@ -0,0 +1,135 @@
+package jikan
+
+import (
+	"context"
+	"testing"
+)
+
+func TestChangeHook(t *testing.T) {
+	var diffs []Diff
+	caches := NewCache(WithChangeHook(ChangeHookFunc(func(ctx context.Context, diff Diff) {
+		diffs = append(diffs, diff)
+	})))
+
+	airing, finished := AnimeStatusAiring, AnimeStatusFinished
+	crunchyroll := Link{Name: "Crunchyroll", URL: "https://crunchyroll.com"}
+
+	anime := AnimeFull{Anime: Anime{MalID: 1, Status: &airing}}
+	if err := caches.Anime().SetAnimeFull(t.Context(), anime); err != nil {
+		t.Fatal(err)
+	}
+
+	// Unchanged values are not reported.
+	if err := caches.Anime().SetAnimeFull(t.Context(), anime); err != nil {
+		t.Fatal(err)
+	}
+
+	anime.Status = &finished
+	anime.Streaming = []Link{crunchyroll}
+	if err := caches.Anime().SetAnimeFull(t.Context(), anime); err != nil {
+		t.Fatal(err)
+	}
+
+	if len(diffs) != 1 {
+		t.Fatalf("expected 1 diff, got %+v", diffs)
+	}
+
+	change, ok := diffs[0].Change(DiffFieldStatus)
+	if !ok || change.Old != airing || change.New != finished {
+		t.Fatalf("unexpected status change: %+v", diffs[0].Changes)
+	}
+
+	if len(diffs[0].NewStreaming) != 1 || diffs[0].NewStreaming[0] != crunchyroll {
+		t.Fatalf("unexpected new streaming links: %+v", diffs[0].NewStreaming)
+	}
+
+	diffs = nil
+	path := "/v4/anime/1/episodes?page=1"
+	page := PaginatedResponseBody[Episode]{Data: []Episode{{MalID: 1, Title: "Episode 1"}}}
+	if err := caches.Lists().SetEpisodeList(t.Context(), path, page, episodeListTTL); err != nil {
+		t.Fatal(err)
+	}
+
+	page.Data = []Episode{{MalID: 1, Title: "Asteroid Blues"}, {MalID: 2, Title: "Stray Dog Strut"}}
+	if err := caches.Lists().SetEpisodeList(t.Context(), path, page, episodeListTTL); err != nil {
+		t.Fatal(err)
+	}
+
+	if len(diffs) != 1 || diffs[0].ID != 1 || len(diffs[0].NewEpisodes) != 1 || len(diffs[0].Episodes) != 1 {
+		t.Fatalf("unexpected episode list diff: %+v", diffs)
+	}
+
+	if change, ok := diffs[0].Episodes[0].Change(DiffFieldTitle); !ok || change.New != "Asteroid Blues" {
+		t.Fatalf("unexpected episode title change: %+v", diffs[0].Episodes[0])
+	}
+}
+
+func TestChangeHookExpired(t *testing.T) {
+	var diffs []Diff
+	caches := NewCache(WithChangeHook(ChangeHookFunc(func(ctx context.Context, diff Diff) {
+		diffs = append(diffs, diff)
+	})))
+
+	airing, finished := AnimeStatusAiring, AnimeStatusFinished
+	if err := caches.Anime().SetAnime(t.Context(), Anime{MalID: 1, Status: &airing}); err != nil {
+		t.Fatal(err)
+	}
+
+	// The entry expires before the refetch, the last seen value is compared instead.
+	if err := caches.InvalidateAnime(t.Context(), "1"); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := caches.Anime().SetAnime(t.Context(), Anime{MalID: 1, Status: &finished}); err != nil {
+		t.Fatal(err)
+	}
+
+	if len(diffs) != 1 {
+		t.Fatalf("expected 1 diff, got %+v", diffs)
+	}
+}
+
+func TestChangeHookBulk(t *testing.T) {
+	var diffs []Diff
+	caches := NewCache(WithChangeHook(ChangeHookFunc(func(ctx context.Context, diff Diff) {
+		diffs = append(diffs, diff)
+	})))
+
+	airing, finished := AnimeStatusAiring, AnimeStatusFinished
+	if err := caches.Anime().BulkSetAnime(t.Context(), []Anime{{MalID: 1, Status: &airing}, {MalID: 2, Status: &airing}}); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := caches.Anime().BulkSetAnime(t.Context(), []Anime{{MalID: 1, Status: &airing}, {MalID: 2, Status: &finished}}); err != nil {
+		t.Fatal(err)
+	}
+
+	if len(diffs) != 1 || diffs[0].ID != 2 {
+		t.Fatalf("expected a diff for anime 2, got %+v", diffs)
+	}
+
+	// Bulk writes are compared with the cached entries, no copies are kept.
+	cfg := newCacheConfig(nil)
+	if seen := peek(t.Context(), caches.Anime().AnimeCache(), cfg.keys.lastSeen(cfg.keys.anime(2))); seen != nil {
+		t.Fatalf("expected no last seen copy of a bulk write, got %+v", seen)
+	}
+
+	// An episode page is written as its episodes and the page, like GetEpisodes.
+	diffs = nil
+	path := "/v4/anime/1/episodes?page=1"
+	for _, title := range []string{"Episode 1", "Asteroid Blues"} {
+		page := PaginatedResponseBody[Episode]{Data: []Episode{{MalID: 1, Title: title}}}
+
+		if err := caches.Anime().BulkSetEpisodes(t.Context(), "1", page.Data); err != nil {
+			t.Fatal(err)
+		}
+
+		if err := caches.Lists().SetEpisodeList(t.Context(), path, page, episodeListTTL); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if len(diffs) != 1 || diffs[0].Resource != "episode-list" || len(diffs[0].Episodes) != 1 {
+		t.Fatalf("expected the change once in the page diff, got %+v", diffs)
+	}
+}
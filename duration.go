@@ -0,0 +1,99 @@
+package jikan
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+var (
+	durationPartPattern = regexp.MustCompile(`(\d+)\s*(hours?|hrs?|h|minutes?|mins?|m|seconds?|secs?|s)\b\.?`)
+	perEpisodePattern   = regexp.MustCompile(`\bper\s+ep(isode)?\b`)
+)
+
+// AnimeDuration is a parsed Anime.Duration.
+type AnimeDuration struct {
+	Duration time.Duration
+	// PerEpisode is true for durations like "24 min per ep", false for the total
+	// runtime of movies, e.g. "1 hr 55 min".
+	PerEpisode bool
+}
+
+// ParseAnimeDuration will parse the durations Jikan sends, such as "24 min per ep",
+// "1 hr 55 min" or "30 sec per ep".
+func ParseAnimeDuration(s string) (AnimeDuration, error) {
+	normalized := strings.ToLower(strings.TrimSpace(s))
+
+	parts := durationPartPattern.FindAllStringSubmatch(normalized, -1)
+	if len(parts) == 0 {
+		return AnimeDuration{}, fmt.Errorf("jikan: unknown duration %q", s)
+	}
+
+	var total time.Duration
+	for _, part := range parts {
+		n, err := strconv.Atoi(part[1])
+		if err != nil {
+			return AnimeDuration{}, fmt.Errorf("jikan: unknown duration %q", s)
+		}
+
+		switch part[2][0] {
+		case 'h':
+			total += time.Duration(n) * time.Hour
+		case 'm':
+			total += time.Duration(n) * time.Minute
+		case 's':
+			total += time.Duration(n) * time.Second
+		}
+	}
+
+	return AnimeDuration{
+		Duration:   total,
+		PerEpisode: perEpisodePattern.MatchString(normalized),
+	}, nil
+}
+
+// ParsedDuration will parse Duration, it returns false when the duration is unknown.
+func (a *Anime) ParsedDuration() (AnimeDuration, bool) {
+	if a.Duration == nil {
+		return AnimeDuration{}, false
+	}
+
+	duration, err := ParseAnimeDuration(*a.Duration)
+	if err != nil {
+		return AnimeDuration{}, false
+	}
+
+	return duration, true
+}
+
+// TotalRuntime will return the runtime of every episode combined.
+//
+// Per-episode durations are multiplied by Episodes, which must be known.
+func (a *Anime) TotalRuntime() (time.Duration, bool) {
+	duration, ok := a.ParsedDuration()
+	if !ok {
+		return 0, false
+	}
+
+	if !duration.PerEpisode {
+		return duration.Duration, true
+	}
+
+	if a.Episodes == nil || *a.Episodes <= 0 {
+		return 0, false
+	}
+
+	return duration.Duration * time.Duration(*a.Episodes), true
+}
+
+// Length will return the runtime of the episode. Duration is only sent by
+// GetEpisodeById, episode lists leave it empty.
+func (e *Episode) Length() (time.Duration, bool) {
+	if e.Duration == nil || *e.Duration <= 0 {
+		return 0, false
+	}
+
+	return time.Duration(*e.Duration) * time.Second, true
+}
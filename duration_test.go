@@ -0,0 +1,31 @@
+package jikan
+
+import (
+	"testing"
+	"time"
+)
+
+func TestParseAnimeDuration(t *testing.T) {
+	for input, expected := range map[string]AnimeDuration{
+		"24 min per ep":        {Duration: 24 * time.Minute, PerEpisode: true},
+		"1 hr 55 min":          {Duration: time.Hour + 55*time.Minute},
+		"2 hr":                 {Duration: 2 * time.Hour},
+		"30 sec per ep":        {Duration: 30 * time.Second, PerEpisode: true},
+		"1 hr 2 min per ep":    {Duration: time.Hour + 2*time.Minute, PerEpisode: true},
+		"7 min 30 sec per ep.": {Duration: 7*time.Minute + 30*time.Second, PerEpisode: true},
+	} {
+		duration, err := ParseAnimeDuration(input)
+		if err != nil || duration != expected {
+			t.Fatalf("ParseAnimeDuration(%q) = %+v, %v", input, duration, err)
+		}
+	}
+
+	if _, err := ParseAnimeDuration("Unknown"); err == nil {
+		t.Fatal("expected an error for an unknown duration")
+	}
+
+	anime := Anime{Duration: new("24 min per ep"), Episodes: new(12)}
+	if total, ok := anime.TotalRuntime(); !ok || total != 288*time.Minute {
+		t.Fatalf("unexpected total runtime: %s", total)
+	}
+}
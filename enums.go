@@ -0,0 +1,187 @@
+package jikan
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+)
+
+// AnimeType is the format of an anime, e.g. TV or Movie.
+//
+// Values Jikan adds later still decode, IsKnown reports whether a value is one of
+// the constants below.
+type AnimeType string
+
+const (
+	AnimeTypeTV        AnimeType = "TV"
+	AnimeTypeOVA       AnimeType = "OVA"
+	AnimeTypeMovie     AnimeType = "Movie"
+	AnimeTypeSpecial   AnimeType = "Special"
+	AnimeTypeONA       AnimeType = "ONA"
+	AnimeTypeMusic     AnimeType = "Music"
+	AnimeTypeCM        AnimeType = "CM"
+	AnimeTypePV        AnimeType = "PV"
+	AnimeTypeTVSpecial AnimeType = "TV Special"
+)
+
+var animeTypes = []AnimeType{AnimeTypeTV, AnimeTypeOVA, AnimeTypeMovie, AnimeTypeSpecial, AnimeTypeONA, AnimeTypeMusic, AnimeTypeCM, AnimeTypePV, AnimeTypeTVSpecial}
+
+func (t AnimeType) String() string {
+	return string(t)
+}
+
+func (t AnimeType) IsKnown() bool {
+	return slices.Contains(animeTypes, t)
+}
+
+// ParseAnimeType will match s case-insensitively against the known anime types.
+func ParseAnimeType(s string) (AnimeType, error) {
+	return parseEnum("anime type", animeTypes, s)
+}
+
+// AnimeStatus is the airing status of an anime.
+type AnimeStatus string
+
+const (
+	AnimeStatusFinished AnimeStatus = "Finished Airing"
+	AnimeStatusAiring   AnimeStatus = "Currently Airing"
+	AnimeStatusUpcoming AnimeStatus = "Not yet aired"
+)
+
+var animeStatuses = []AnimeStatus{AnimeStatusFinished, AnimeStatusAiring, AnimeStatusUpcoming}
+
+func (s AnimeStatus) String() string {
+	return string(s)
+}
+
+func (s AnimeStatus) IsKnown() bool {
+	return slices.Contains(animeStatuses, s)
+}
+
+// ParseAnimeStatus will match s case-insensitively against the known statuses.
+func ParseAnimeStatus(s string) (AnimeStatus, error) {
+	return parseEnum("anime status", animeStatuses, s)
+}
+
+// AnimeRating is the audience rating of an anime.
+type AnimeRating string
+
+const (
+	AnimeRatingG     AnimeRating = "G - All Ages"
+	AnimeRatingPG    AnimeRating = "PG - Children"
+	AnimeRatingPG13  AnimeRating = "PG-13 - Teens 13 or older"
+	AnimeRatingR17   AnimeRating = "R - 17+ (violence & profanity)"
+	AnimeRatingRPlus AnimeRating = "R+ - Mild Nudity"
+	AnimeRatingRx    AnimeRating = "Rx - Hentai"
+)
+
+var animeRatings = []AnimeRating{AnimeRatingG, AnimeRatingPG, AnimeRatingPG13, AnimeRatingR17, AnimeRatingRPlus, AnimeRatingRx}
+
+func (r AnimeRating) String() string {
+	return string(r)
+}
+
+func (r AnimeRating) IsKnown() bool {
+	return slices.Contains(animeRatings, r)
+}
+
+// IsExplicit will report whether the rating is restricted to adults, that is
+// R - 17+, R+ or Rx. Unknown ratings starting with "R" are treated as explicit.
+func (r AnimeRating) IsExplicit() bool {
+	switch r {
+	case AnimeRatingR17, AnimeRatingRPlus, AnimeRatingRx:
+		return true
+	}
+
+	return !r.IsKnown() && strings.HasPrefix(string(r), "R")
+}
+
+// IsHentai will report whether the rating is Rx.
+func (r AnimeRating) IsHentai() bool {
+	return r == AnimeRatingRx
+}
+
+// ParseAnimeRating will match s case-insensitively against the known ratings.
+func ParseAnimeRating(s string) (AnimeRating, error) {
+	return parseEnum("anime rating", animeRatings, s)
+}
+
+// AnimeSeason is the season of the year an anime premiered in.
+type AnimeSeason string
+
+const (
+	AnimeSeasonWinter AnimeSeason = "winter"
+	AnimeSeasonSpring AnimeSeason = "spring"
+	AnimeSeasonSummer AnimeSeason = "summer"
+	AnimeSeasonFall   AnimeSeason = "fall"
+)
+
+var animeSeasons = []AnimeSeason{AnimeSeasonWinter, AnimeSeasonSpring, AnimeSeasonSummer, AnimeSeasonFall}
+
+func (s AnimeSeason) String() string {
+	return string(s)
+}
+
+func (s AnimeSeason) IsKnown() bool {
+	return slices.Contains(animeSeasons, s)
+}
+
+// ParseAnimeSeason will match s case-insensitively against the known seasons.
+func ParseAnimeSeason(s string) (AnimeSeason, error) {
+	return parseEnum("anime season", animeSeasons, s)
+}
+
+// AnimeSource is the material an anime is adapted from.
+type AnimeSource string
+
+const (
+	AnimeSourceOriginal     AnimeSource = "Original"
+	AnimeSourceManga        AnimeSource = "Manga"
+	AnimeSource4KomaManga   AnimeSource = "4-koma manga"
+	AnimeSourceWebManga     AnimeSource = "Web manga"
+	AnimeSourceDigitalManga AnimeSource = "Digital manga"
+	AnimeSourceNovel        AnimeSource = "Novel"
+	AnimeSourceLightNovel   AnimeSource = "Light novel"
+	AnimeSourceVisualNovel  AnimeSource = "Visual novel"
+	AnimeSourceWebNovel     AnimeSource = "Web novel"
+	AnimeSourceGame         AnimeSource = "Game"
+	AnimeSourceCardGame     AnimeSource = "Card game"
+	AnimeSourceBook         AnimeSource = "Book"
+	AnimeSourcePictureBook  AnimeSource = "Picture book"
+	AnimeSourceRadio        AnimeSource = "Radio"
+	AnimeSourceMusic        AnimeSource = "Music"
+	AnimeSourceMixedMedia   AnimeSource = "Mixed media"
+	AnimeSourceOther        AnimeSource = "Other"
+	AnimeSourceUnknown      AnimeSource = "Unknown"
+)
+
+var animeSources = []AnimeSource{
+	AnimeSourceOriginal, AnimeSourceManga, AnimeSource4KomaManga, AnimeSourceWebManga, AnimeSourceDigitalManga,
+	AnimeSourceNovel, AnimeSourceLightNovel, AnimeSourceVisualNovel, AnimeSourceWebNovel, AnimeSourceGame,
+	AnimeSourceCardGame, AnimeSourceBook, AnimeSourcePictureBook, AnimeSourceRadio, AnimeSourceMusic,
+	AnimeSourceMixedMedia, AnimeSourceOther, AnimeSourceUnknown,
+}
+
+func (s AnimeSource) String() string {
+	return string(s)
+}
+
+func (s AnimeSource) IsKnown() bool {
+	return slices.Contains(animeSources, s)
+}
+
+// ParseAnimeSource will match s case-insensitively against the known sources.
+func ParseAnimeSource(s string) (AnimeSource, error) {
+	return parseEnum("anime source", animeSources, s)
+}
+
+func parseEnum[T ~string](name string, known []T, s string) (T, error) {
+	s = strings.TrimSpace(s)
+	for _, k := range known {
+		if strings.EqualFold(string(k), s) {
+			return k, nil
+		}
+	}
+
+	return "", fmt.Errorf("jikan: unknown %s %q", name, s)
+}
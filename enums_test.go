@@ -0,0 +1,34 @@
+package jikan
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestAnimeRating(t *testing.T) {
+	var anime Anime
+	if err := json.Unmarshal([]byte(`{"rating":"R - 17+ (violence & profanity)","type":"Future Type"}`), &anime); err != nil {
+		t.Fatal(err)
+	}
+
+	if !anime.IsExplicit() || anime.IsHentai() {
+		t.Fatalf("expected R - 17+ to be explicit but not hentai")
+	}
+
+	if anime.Type == nil || anime.Type.IsKnown() {
+		t.Fatalf("expected unknown type to decode, got %v", anime.Type)
+	}
+
+	rating, err := ParseAnimeRating("rx - hentai")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !rating.IsHentai() {
+		t.Fatalf("expected %q to be hentai", rating)
+	}
+
+	if !AnimeRating("R18 - Future Rating").IsExplicit() || AnimeRating("PG - Future Rating").IsExplicit() {
+		t.Fatal("expected unknown ratings starting with R to be explicit")
+	}
+}
@@ -0,0 +1,66 @@
+package jikan
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+)
+
+var (
+	// ErrNotFound is matched by errors for resources that do not exist on Jikan.
+	ErrNotFound = errors.New("not found")
+	// ErrInvalidQuery is matched by errors for query parameters rejected before a request is sent.
+	ErrInvalidQuery = errors.New("invalid query")
+)
+
+// ErrorResponse is returned when Jikan responds with a non-2xx status code.
+//
+// https://docs.api.jikan.moe/#/section/information/json-notes
+type ErrorResponse struct {
+	Response *http.Response `json:"-"`
+
+	Type    string `json:"type"`
+	Message string `json:"message"`
+	Err     string `json:"error"`
+}
+
+func (e *ErrorResponse) Error() string {
+	if e.Message != "" {
+		return fmt.Sprintf("jikan: %s: %s", e.Response.Status, e.Message)
+	}
+
+	return "jikan: " + e.Response.Status
+}
+
+// Is will report whether the response matches target, this allows
+// `errors.Is(err, ErrNotFound)` for 404 responses.
+func (e *ErrorResponse) Is(target error) bool {
+	return target == ErrNotFound && e.Response.StatusCode == http.StatusNotFound
+}
+
+// NotFoundError is returned when a resource does not exist, either because Jikan
+// responded with a 404 or because a previous 404 was cached.
+type NotFoundError struct {
+	Resource string
+	ID       string
+
+	// Err is the response error from Jikan, it is nil when the result came from the cache.
+	Err error
+}
+
+func (e *NotFoundError) Error() string {
+	return fmt.Sprintf("jikan: %s %s not found", e.Resource, e.ID)
+}
+
+func (e *NotFoundError) Is(target error) bool {
+	return target == ErrNotFound
+}
+
+func (e *NotFoundError) Unwrap() error {
+	return e.Err
+}
+
+// invalidQuery will create an error for a rejected query parameter.
+func invalidQuery(param string, format string, args ...any) error {
+	return fmt.Errorf("jikan: %w: %s %s", ErrInvalidQuery, param, fmt.Sprintf(format, args...))
+}
@@ -0,0 +1,193 @@
+package jikan
+
+import (
+	"context"
+	"errors"
+	"slices"
+)
+
+// RelationKind is how a related entry relates to an anime.
+type RelationKind string
+
+const (
+	RelationSequel             RelationKind = "Sequel"
+	RelationPrequel            RelationKind = "Prequel"
+	RelationSideStory          RelationKind = "Side Story"
+	RelationParentStory        RelationKind = "Parent Story"
+	RelationFullStory          RelationKind = "Full Story"
+	RelationSummary            RelationKind = "Summary"
+	RelationAlternativeVersion RelationKind = "Alternative Version"
+	RelationAlternativeSetting RelationKind = "Alternative Setting"
+	RelationSpinOff            RelationKind = "Spin-Off"
+	RelationCharacter          RelationKind = "Character"
+	RelationAdaptation         RelationKind = "Adaptation"
+	RelationOther              RelationKind = "Other"
+)
+
+const (
+	// defaultFranchiseDepth and defaultFranchiseNodes bound a crawl, every node
+	// is a request so an unbounded crawl can take minutes.
+	defaultFranchiseDepth = 10
+	defaultFranchiseNodes = 50
+)
+
+// defaultFranchiseKinds leaves out Character and Other, they link unrelated
+// franchises and would pull them into the graph.
+var defaultFranchiseKinds = []RelationKind{
+	RelationSequel,
+	RelationPrequel,
+	RelationSideStory,
+	RelationParentStory,
+	RelationFullStory,
+	RelationSummary,
+	RelationAlternativeVersion,
+	RelationAlternativeSetting,
+	RelationSpinOff,
+	RelationAdaptation,
+}
+
+// FranchiseOptions limit how far a franchise is crawled.
+type FranchiseOptions struct {
+	// MaxDepth is the number of hops from the root that are followed, defaults
+	// to 10. A negative value means no limit.
+	MaxDepth int
+	// MaxNodes stops crawling after this many anime, defaults to 50. A negative
+	// value means no limit.
+	MaxNodes int
+	// Kinds are the relations that are followed, defaults to every relation
+	// except Character and Other.
+	Kinds []RelationKind
+}
+
+// FranchiseNode is an anime in a franchise graph.
+type FranchiseNode struct {
+	Anime AnimeFull
+	// Depth is the number of hops from the root.
+	Depth int
+}
+
+// FranchiseEdge is a relation between two anime, read as "To is the Kind of From".
+type FranchiseEdge struct {
+	From AnimeID
+	To   AnimeID
+	Kind RelationKind
+}
+
+// FranchiseGraph is a set of anime connected by their relations.
+type FranchiseGraph struct {
+	Root  AnimeID
+	Nodes map[AnimeID]*FranchiseNode
+	// Edges only connect anime that are in Nodes.
+	Edges []FranchiseEdge
+	// Truncated is true when a limit stopped the crawl before every relation was followed.
+	Truncated bool
+}
+
+// EdgesFrom will return the edges starting at id, optionally only of the given kinds.
+func (g *FranchiseGraph) EdgesFrom(id AnimeID, kinds ...RelationKind) []FranchiseEdge {
+	var edges []FranchiseEdge
+	for _, edge := range g.Edges {
+		if edge.From == id && (len(kinds) == 0 || slices.Contains(kinds, edge.Kind)) {
+			edges = append(edges, edge)
+		}
+	}
+
+	return edges
+}
+
+// Franchise will crawl the relations of an anime breadth-first, fetching every
+// related anime with GetFullByMalID so results are cached and rate limited.
+//
+// Related anime that no longer exist are skipped, any other error stops the crawl
+// and is returned with the graph crawled so far.
+func (s *AnimeEndpoints) Franchise(ctx context.Context, root AnimeID, opts *FranchiseOptions) (*FranchiseGraph, error) {
+	options := FranchiseOptions{}
+	if opts != nil {
+		options = *opts
+	}
+
+	if options.MaxDepth == 0 {
+		options.MaxDepth = defaultFranchiseDepth
+	}
+
+	if options.MaxNodes == 0 {
+		options.MaxNodes = defaultFranchiseNodes
+	}
+
+	if len(options.Kinds) == 0 {
+		options.Kinds = defaultFranchiseKinds
+	}
+
+	graph := &FranchiseGraph{
+		Root:  root,
+		Nodes: make(map[AnimeID]*FranchiseNode),
+	}
+
+	type queued struct {
+		id    AnimeID
+		depth int
+	}
+
+	queue := []queued{{id: root}}
+	seen := map[AnimeID]bool{root: true}
+
+	for len(queue) > 0 {
+		next := queue[0]
+		queue = queue[1:]
+
+		if options.MaxNodes > 0 && len(graph.Nodes) >= options.MaxNodes {
+			graph.Truncated = true
+			break
+		}
+
+		anime, _, err := s.GetFullByMalID(ctx, next.id)
+		if err != nil {
+			if errors.Is(err, ErrNotFound) && next.id != root {
+				continue
+			}
+
+			graph.prune()
+			return graph, err
+		}
+
+		graph.Nodes[next.id] = &FranchiseNode{Anime: *anime, Depth: next.depth}
+
+		for _, relation := range anime.Relations {
+			if !slices.Contains(options.Kinds, relation.Relation) {
+				continue
+			}
+
+			for _, entry := range relation.Entry {
+				if entry.Type != "anime" {
+					continue
+				}
+
+				to := AnimeID(entry.MalID)
+				graph.Edges = append(graph.Edges, FranchiseEdge{From: next.id, To: to, Kind: relation.Relation})
+
+				if seen[to] {
+					continue
+				}
+
+				if options.MaxDepth > 0 && next.depth >= options.MaxDepth {
+					graph.Truncated = true
+					continue
+				}
+
+				seen[to] = true
+				queue = append(queue, queued{id: to, depth: next.depth + 1})
+			}
+		}
+	}
+
+	graph.prune()
+	return graph, nil
+}
+
+// prune will drop the edges to anime that are not in Nodes.
+func (g *FranchiseGraph) prune() {
+	g.Edges = slices.DeleteFunc(g.Edges, func(edge FranchiseEdge) bool {
+		_, ok := g.Nodes[edge.To]
+		return !ok
+	})
+}
@@ -0,0 +1,113 @@
+package jikan
+
+import (
+	"encoding/json"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+// franchiseFixture is a small franchise: 1 -> 2 -> 3 as sequels, a side story 4
+// of 2, a summary 5 of 1 and an alternative version 6 of 1. Anime 7 only shares a
+// character with 3.
+var franchiseFixture = map[string]AnimeFull{
+	"1": fixtureAnime(1, "2019-01-01", RelationSequel, 2, RelationSummary, 5, RelationAlternativeVersion, 6, RelationAdaptation, 100),
+	"2": fixtureAnime(2, "2020-01-01", RelationPrequel, 1, RelationSequel, 3, RelationSideStory, 4),
+	"3": fixtureAnime(3, "2022-01-01", RelationPrequel, 2, RelationCharacter, 7),
+	"4": fixtureAnime(4, "2021-01-01", RelationParentStory, 2),
+	"5": fixtureAnime(5, "2019-06-01", RelationFullStory, 1),
+	"6": fixtureAnime(6, "2018-01-01", RelationAlternativeVersion, 1),
+	"7": fixtureAnime(7, "2015-01-01", RelationCharacter, 3),
+}
+
+func fixtureAnime(id int, from string, relations ...any) AnimeFull {
+	anime := AnimeFull{Anime: Anime{MalID: id, Aired: AiredInfo{From: from + "T00:00:00+00:00"}}}
+
+	for i := 0; i < len(relations); i += 2 {
+		entity := Entity{MalID: relations[i+1].(int), Type: "anime"}
+		if entity.MalID == 100 {
+			entity.Type = "manga"
+		}
+
+		anime.Relations = append(anime.Relations, Relation{
+			Relation: relations[i].(RelationKind),
+			Entry:    []Entity{entity},
+		})
+	}
+
+	return anime
+}
+
+func newFranchiseClient(t *testing.T) *Client {
+	return newTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		id := strings.TrimSuffix(strings.TrimPrefix(r.URL.Path, "/v4/anime/"), "/full")
+
+		anime, ok := franchiseFixture[id]
+		if !ok {
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = w.Write([]byte(`{"status":404,"message":"Resource does not exist"}`))
+			return
+		}
+
+		_ = json.NewEncoder(w).Encode(ResponseBody[AnimeFull]{Data: anime})
+	}))
+}
+
+func TestFranchise(t *testing.T) {
+	client := newFranchiseClient(t)
+
+	graph, err := client.Anime.Franchise(t.Context(), 3, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(graph.Nodes) != 6 || graph.Truncated {
+		t.Fatalf("expected 6 nodes, got %d (truncated: %v)", len(graph.Nodes), graph.Truncated)
+	}
+
+	if graph.Nodes[1].Depth != 2 {
+		t.Fatalf("expected anime 1 at depth 2, got %d", graph.Nodes[1].Depth)
+	}
+
+	if edges := graph.EdgesFrom(2, RelationSequel); len(edges) != 1 || edges[0].To != 3 {
+		t.Fatalf("unexpected sequel edges: %+v", edges)
+	}
+
+	graph, err = client.Anime.Franchise(t.Context(), 3, &FranchiseOptions{MaxDepth: 1})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(graph.Nodes) != 2 || !graph.Truncated {
+		t.Fatalf("expected 2 nodes and a truncated graph, got %d (truncated: %v)", len(graph.Nodes), graph.Truncated)
+	}
+
+	graph, err = client.Anime.Franchise(t.Context(), 3, &FranchiseOptions{MaxNodes: -1, Kinds: []RelationKind{RelationPrequel, RelationCharacter}})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if _, ok := graph.Nodes[7]; !ok || len(graph.Nodes) != 4 {
+		t.Fatalf("expected the character relation to be followed, got %d nodes", len(graph.Nodes))
+	}
+}
+
+func TestFranchiseError(t *testing.T) {
+	client := newTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/v4/anime/3/full" {
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+
+		_ = json.NewEncoder(w).Encode(ResponseBody[AnimeFull]{Data: franchiseFixture["3"]})
+	}))
+
+	graph, err := client.Anime.Franchise(t.Context(), 3, nil)
+	if err == nil {
+		t.Fatal("expected the failed fetch to stop the crawl")
+	}
+
+	if len(graph.Nodes) != 1 || len(graph.Edges) != 0 {
+		t.Fatalf("expected only anime 3 and no dangling edges, got %d nodes and %+v", len(graph.Nodes), graph.Edges)
+	}
+}
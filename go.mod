@@ -5,6 +5,7 @@ go 1.26.0
 toolchain go1.24.11
 
 require (
+	github.com/alicebob/miniredis/v2 v2.39.0
 	github.com/redis/go-redis/v9 v9.17.2
 	golang.org/x/sync v0.19.0
 	golang.org/x/time v0.14.0
@@ -13,4 +14,5 @@ require (
 require (
 	github.com/cespare/xxhash/v2 v2.3.0 // indirect
 	github.com/dgryski/go-rendezvous v0.0.0-20200823014737-9f7001d12a5f // indirect
+	github.com/yuin/gopher-lua v1.1.1 // indirect
 )
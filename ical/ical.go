@@ -0,0 +1,291 @@
+// Package ical exports anime broadcast schedules as an RFC 5545 calendar feed.
+package ical
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/minnasync/jikan-go"
+)
+
+const (
+	// DefaultProductID is used for PRODID when Options.ProductID is empty.
+	DefaultProductID = "-//minnasync//jikan-go//EN"
+	// DefaultEventLength is the event length when the episode duration is unknown.
+	DefaultEventLength = 30 * time.Minute
+
+	timestampFormat = "20060102T150405Z"
+	localFormat     = "20060102T150405"
+	maxLineOctets   = 75
+
+	// zoneHorizon is how far past the first broadcast timezone transitions are
+	// written for events that recur without an end.
+	zoneHorizon = 2 * 365 * 24 * time.Hour
+)
+
+// Options are used to configure the calendar feed.
+type Options struct {
+	// Name is shown by calendar apps as the calendar name.
+	Name string
+	// ProductID is the PRODID of the feed, defaults to DefaultProductID.
+	ProductID string
+	// Now is used for DTSTAMP and to anchor airing anime without a known premiere
+	// date, defaults to time.Now.
+	Now time.Time
+}
+
+// Write will write a calendar with a weekly recurring event for every anime with
+// a known broadcast schedule. Anime with an unknown schedule are skipped, as are
+// finished anime without a known premiere date.
+//
+// Events are written in the broadcast timezone, described by a VTIMEZONE, so
+// they keep their local time across daylight saving changes. They recur until
+// the final broadcast when the episode count or end date is known.
+func Write(w io.Writer, anime []jikan.Anime, opts *Options) error {
+	if opts == nil {
+		opts = &Options{}
+	}
+
+	now := opts.Now
+	if now.IsZero() {
+		now = time.Now()
+	}
+
+	productID := opts.ProductID
+	if productID == "" {
+		productID = DefaultProductID
+	}
+
+	type event struct {
+		anime    *jikan.Anime
+		schedule jikan.BroadcastSchedule
+		start    time.Time
+	}
+
+	var events []event
+	zones := make(map[string]*zoneRange)
+	var zoneOrder []string
+
+	for i := range anime {
+		a := &anime[i]
+
+		schedule, ok := a.BroadcastSchedule()
+		if !ok {
+			continue
+		}
+
+		start := schedule.First
+		if start.IsZero() {
+			times := a.NextBroadcasts(now, 1)
+			if len(times) == 0 {
+				continue
+			}
+
+			start = times[0]
+		}
+
+		events = append(events, event{anime: a, schedule: schedule, start: start})
+
+		until := schedule.Last
+		if until.IsZero() {
+			until = start.Add(zoneHorizon)
+		}
+
+		name := schedule.Location.String()
+		if zone, ok := zones[name]; ok {
+			zone.include(start, until)
+		} else {
+			zones[name] = &zoneRange{loc: schedule.Location, from: start, to: until}
+			zoneOrder = append(zoneOrder, name)
+		}
+	}
+
+	bw := bufio.NewWriter(w)
+	line := func(name, value string) {
+		writeLine(bw, name+":"+value)
+	}
+
+	line("BEGIN", "VCALENDAR")
+	line("VERSION", "2.0")
+	line("PRODID", escape(productID))
+	line("CALSCALE", "GREGORIAN")
+	if opts.Name != "" {
+		line("X-WR-CALNAME", escape(opts.Name))
+	}
+
+	for _, name := range zoneOrder {
+		zones[name].write(line)
+	}
+
+	for _, e := range events {
+		length := DefaultEventLength
+		if d, ok := e.anime.ParsedDuration(); ok && d.PerEpisode && d.Duration > 0 {
+			length = d.Duration
+		}
+
+		// UNTIL must be in UTC when DTSTART has a timezone.
+		rule := "FREQ=WEEKLY"
+		if !e.schedule.Last.IsZero() {
+			rule += ";UNTIL=" + e.schedule.Last.UTC().Format(timestampFormat)
+		}
+
+		loc := e.schedule.Location
+		tzid := ";TZID=" + paramValue(loc.String())
+
+		line("BEGIN", "VEVENT")
+		line("UID", UID(e.anime.MalID))
+		line("DTSTAMP", now.UTC().Format(timestampFormat))
+		line("DTSTART"+tzid, e.start.In(loc).Format(localFormat))
+		line("DTEND"+tzid, e.start.Add(length).In(loc).Format(localFormat))
+		line("RRULE", rule)
+		line("SUMMARY", escape(e.anime.PreferredTitle(jikan.TitleTypeEnglish, jikan.TitleTypeDefault)))
+		if e.anime.Broadcast.Text != "" {
+			line("DESCRIPTION", escape("Broadcast: "+e.anime.Broadcast.Text))
+		}
+		if e.anime.URL != "" {
+			line("URL", e.anime.URL)
+		}
+		line("END", "VEVENT")
+	}
+
+	line("END", "VCALENDAR")
+	return bw.Flush()
+}
+
+// Marshal will return the calendar written by Write.
+func Marshal(anime []jikan.Anime, opts *Options) ([]byte, error) {
+	var b bytes.Buffer
+	if err := Write(&b, anime, opts); err != nil {
+		return nil, err
+	}
+
+	return b.Bytes(), nil
+}
+
+// UID will return the stable event UID of an anime.
+func UID(malID int) string {
+	return "anime-" + strconv.Itoa(malID) + "@jikan-go"
+}
+
+// zoneRange is a timezone and the period its events span.
+type zoneRange struct {
+	loc      *time.Location
+	from, to time.Time
+}
+
+func (z *zoneRange) include(from, to time.Time) {
+	if from.Before(z.from) {
+		z.from = from
+	}
+
+	if to.After(z.to) {
+		z.to = to
+	}
+}
+
+// write will write a VTIMEZONE with one observance for the offset at the start
+// of the range and one for every transition within it.
+func (z *zoneRange) write(line func(name, value string)) {
+	line("BEGIN", "VTIMEZONE")
+	line("TZID", z.loc.String())
+
+	t := z.from.In(z.loc)
+	_, offset := t.Zone()
+	observance(line, t, offset, offset)
+
+	for {
+		_, end := t.ZoneBounds()
+		if end.IsZero() || end.After(z.to) {
+			break
+		}
+
+		t = end.In(z.loc)
+		_, next := t.Zone()
+		observance(line, t, offset, next)
+		offset = next
+	}
+
+	line("END", "VTIMEZONE")
+}
+
+// observance will write a STANDARD or DAYLIGHT component starting at t, its
+// DTSTART is in the local time before the transition.
+func observance(line func(name, value string), t time.Time, from, to int) {
+	kind := "STANDARD"
+	if t.IsDST() {
+		kind = "DAYLIGHT"
+	}
+
+	name, _ := t.Zone()
+
+	line("BEGIN", kind)
+	line("DTSTART", t.In(time.FixedZone("", from)).Format(localFormat))
+	line("TZOFFSETFROM", formatOffset(from))
+	line("TZOFFSETTO", formatOffset(to))
+	if name != "" {
+		line("TZNAME", escape(name))
+	}
+	line("END", kind)
+}
+
+// formatOffset will format a UTC offset in seconds, e.g. "+0900".
+func formatOffset(offset int) string {
+	sign := "+"
+	if offset < 0 {
+		sign = "-"
+		offset = -offset
+	}
+
+	s := fmt.Sprintf("%s%02d%02d", sign, offset/3600, offset/60%60)
+	if offset%60 != 0 {
+		s += fmt.Sprintf("%02d", offset%60)
+	}
+
+	return s
+}
+
+// paramValue will quote a parameter value containing characters that end it.
+func paramValue(s string) string {
+	if strings.ContainsAny(s, ":;,") {
+		return `"` + s + `"`
+	}
+
+	return s
+}
+
+// escape will escape a TEXT value.
+func escape(s string) string {
+	return strings.NewReplacer(
+		`\`, `\\`,
+		";", `\;`,
+		",", `\,`,
+		"\r\n", `\n`,
+		"\n", `\n`,
+	).Replace(s)
+}
+
+// writeLine will write a content line, folding it at 75 octets without splitting
+// UTF-8 sequences.
+func writeLine(w *bufio.Writer, s string) {
+	limit := maxLineOctets
+	for len(s) > limit {
+		cut := limit
+		for cut > 0 && !utf8.RuneStart(s[cut]) {
+			cut--
+		}
+
+		fmt.Fprintf(w, "%s\r\n ", s[:cut])
+		s = s[cut:]
+
+		// Continuation lines start with a space, which counts towards the limit.
+		limit = maxLineOctets - 1
+	}
+
+	fmt.Fprintf(w, "%s\r\n", s)
+}
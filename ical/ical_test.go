@@ -0,0 +1,94 @@
+package ical
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/minnasync/jikan-go"
+)
+
+func TestWrite(t *testing.T) {
+	episodes := 12
+	duration := "24 min per ep"
+
+	anime := []jikan.Anime{
+		{
+			MalID:    1,
+			URL:      "https://myanimelist.net/anime/1",
+			Title:    "Cowboy Bebop, Session; " + strings.Repeat("ア", 30),
+			Episodes: &episodes,
+			Duration: &duration,
+			Aired:    jikan.AiredInfo{From: "2024-04-06T00:00:00+00:00"},
+			Broadcast: jikan.BroadcastInfo{
+				Day:      "Saturdays",
+				Time:     "01:30",
+				Timezone: "Asia/Tokyo",
+			},
+		},
+		// No broadcast schedule, skipped.
+		{MalID: 2, Title: "Unknown"},
+	}
+
+	out, err := Marshal(anime, &Options{Now: time.Date(2024, 4, 1, 0, 0, 0, 0, time.UTC)})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	feed := string(out)
+	for _, expected := range []string{
+		"UID:anime-1@jikan-go\r\n",
+		"BEGIN:VTIMEZONE\r\nTZID:Asia/Tokyo\r\n",
+		"TZOFFSETTO:+0900\r\n",
+		"DTSTART;TZID=Asia/Tokyo:20240406T013000\r\n",
+		"DTEND;TZID=Asia/Tokyo:20240406T015400\r\n",
+		"RRULE:FREQ=WEEKLY;UNTIL=20240621T163000Z\r\n",
+		`SUMMARY:Cowboy Bebop\, Session\; `,
+	} {
+		if !strings.Contains(feed, expected) {
+			t.Fatalf("expected %q in feed:\n%s", expected, feed)
+		}
+	}
+
+	if strings.Count(feed, "BEGIN:VEVENT") != 1 {
+		t.Fatalf("expected 1 event:\n%s", feed)
+	}
+
+	for _, line := range strings.Split(strings.TrimSuffix(feed, "\r\n"), "\r\n") {
+		if len(line) > 75 {
+			t.Fatalf("line longer than 75 octets: %q", line)
+		}
+	}
+}
+
+func TestWriteDaylightSaving(t *testing.T) {
+	episodes := 12
+
+	anime := []jikan.Anime{{
+		MalID:    1,
+		Title:    "Simulcast",
+		Episodes: &episodes,
+		Aired:    jikan.AiredInfo{From: "2024-03-03T00:00:00+00:00"},
+		Broadcast: jikan.BroadcastInfo{
+			Day:      "Sundays",
+			Time:     "20:00",
+			Timezone: "America/New_York",
+		},
+	}}
+
+	out, err := Marshal(anime, &Options{Now: time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC)})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// The series starts in EST and crosses into EDT on March 10th.
+	feed := string(out)
+	for _, expected := range []string{
+		"DTSTART;TZID=America/New_York:20240303T200000\r\n",
+		"BEGIN:DAYLIGHT\r\nDTSTART:20240310T020000\r\nTZOFFSETFROM:-0500\r\nTZOFFSETTO:-0400\r\n",
+	} {
+		if !strings.Contains(feed, expected) {
+			t.Fatalf("expected %q in feed:\n%s", expected, feed)
+		}
+	}
+}
@@ -0,0 +1,78 @@
+package jikan
+
+import (
+	"context"
+	"net/http"
+)
+
+// Operations are the logical names of the endpoints, passed to interceptors.
+const (
+	OperationAnimeGetFullById    = "anime.getFullById"
+	OperationAnimeGetById        = "anime.getById"
+	OperationAnimeGetEpisodes    = "anime.getEpisodes"
+	OperationAnimeGetEpisodeById = "anime.getEpisodeById"
+	OperationAnimeGetSearch      = "anime.getSearch"
+	OperationSeasonsGetNow       = "seasons.getNow"
+	OperationSeasonsGet          = "seasons.get"
+	OperationSeasonsGetList      = "seasons.getList"
+	OperationSeasonsGetUpcoming  = "seasons.getUpcoming"
+	OperationTopGetTopAnime      = "top.getTopAnime"
+)
+
+// Request is a request passing through the interceptor chain.
+type Request struct {
+	// Operation is the endpoint making the request, e.g. OperationAnimeGetFullById.
+	// It is empty for requests made by calling Client.Do directly.
+	Operation string
+	Request   *http.Request
+}
+
+// RequestHandler executes a request.
+//
+// Like an http.RoundTripper, a handler returning a nil error must return a
+// response with a non-nil Body. Non-2xx responses are turned into an
+// *ErrorResponse by Client.Do, so handlers should not treat them as errors.
+type RequestHandler func(req *Request) (*http.Response, error)
+
+// Interceptor wraps the handler executing a request. It can change the request
+// before calling next, inspect or replace the response, or return without
+// calling next at all, e.g. to serve a response from its own cache.
+type Interceptor func(next RequestHandler) RequestHandler
+
+// WithInterceptors will run every request through interceptors. The first
+// interceptor is the outermost, so it sees the request first and the response last.
+//
+// Interceptors only see requests that reach the network, responses served from
+// Caches never pass through them.
+func WithInterceptors(interceptors ...Interceptor) ClientOption {
+	return func(c *Client) {
+		c.interceptors = append(c.interceptors, interceptors...)
+	}
+}
+
+type operationKey struct{}
+
+// withOperation will return a context carrying the operation name of an endpoint.
+func withOperation(ctx context.Context, operation string) context.Context {
+	return context.WithValue(ctx, operationKey{}, operation)
+}
+
+// OperationFromContext will return the operation name set by the endpoint making
+// a request, so an http.RoundTripper can see it as well.
+func OperationFromContext(ctx context.Context) (string, bool) {
+	operation, ok := ctx.Value(operationKey{}).(string)
+	return operation, ok
+}
+
+// buildHandler will wrap the HTTP client with the configured interceptors.
+func (c *Client) buildHandler() RequestHandler {
+	handler := func(req *Request) (*http.Response, error) {
+		return c.client.Do(req.Request)
+	}
+
+	for i := len(c.interceptors) - 1; i >= 0; i-- {
+		handler = c.interceptors[i](handler)
+	}
+
+	return handler
+}
@@ -1,6 +1,7 @@
 package httpx
 
 import (
+	"context"
 	"net/http"
 	"time"
 
@@ -8,19 +9,48 @@ import (
 )
 
 var (
-	// Jikan allows 3 requests per second or 60 request per minute.
-	limiter = rate.NewLimiter(
+	// Limiter is shared by every client, Jikan allows 3 requests per second or
+	// 60 request per minute.
+	Limiter = rate.NewLimiter(
 		rate.Every(time.Minute/60),
 		3,
 	)
 )
 
+// Stats are filled in by the round trippers for a request.
+type Stats struct {
+	// LimiterWait is the time spent waiting on the rate limiter.
+	LimiterWait time.Duration
+}
+
+type statsKey struct{}
+
+// WithStats will return a context that makes the round trippers record into stats.
+func WithStats(ctx context.Context, stats *Stats) context.Context {
+	return context.WithValue(ctx, statsKey{}, stats)
+}
+
 type RequestLimitRoundTripper struct {
 	http.RoundTripper
+
+	// Limiter throttles requests, defaults to the shared Limiter.
+	Limiter *rate.Limiter
 }
 
 func (r *RequestLimitRoundTripper) RoundTrip(req *http.Request) (*http.Response, error) {
-	if err := limiter.Wait(req.Context()); err != nil {
+	limiter := r.Limiter
+	if limiter == nil {
+		limiter = Limiter
+	}
+
+	start := time.Now()
+	err := limiter.Wait(req.Context())
+
+	if stats, ok := req.Context().Value(statsKey{}).(*Stats); ok {
+		stats.LimiterWait += time.Since(start)
+	}
+
+	if err != nil {
 		return nil, err
 	}
 
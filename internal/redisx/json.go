@@ -7,34 +7,41 @@ import (
 	"github.com/redis/go-redis/v9"
 )
 
-func JSONUnwrap[T any](ctx context.Context, r *redis.Client, key string, selector string, data *T) error {
-	cmd := r.JSONGet(ctx, key, selector)
-
-	err := cmd.Err()
+// JSONGet will fetch the raw result of a JSONPath selector, returning redis.Nil
+// when the key does not exist.
+func JSONGet(ctx context.Context, r *redis.Client, key string, selector string) (string, error) {
+	raw, err := r.JSONGet(ctx, key, selector).Result()
 	if err != nil {
-		return err
+		return "", err
 	}
 
-	if cmd.Val() == "" {
-		return nil
+	if raw == "" {
+		return "", redis.Nil
 	}
 
-	expanded, err := cmd.Expanded()
-	if err != nil {
+	return raw, nil
+}
+
+// JSONDecodeFirst will decode the first match of a JSONPath result into data,
+// returning redis.Nil when nothing matched.
+func JSONDecodeFirst[T any](raw string, data *T) error {
+	var entries []json.RawMessage
+	if err := json.Unmarshal([]byte(raw), &entries); err != nil {
 		return err
 	}
 
-	entries := expanded.([]any)
-	entry := entries[0]
-
-	if entry == nil {
-		return nil
+	if len(entries) == 0 || string(entries[0]) == "null" {
+		return redis.Nil
 	}
 
-	jsonB, err := json.Marshal(entry)
+	return json.Unmarshal(entries[0], data)
+}
+
+func JSONUnwrap[T any](ctx context.Context, r *redis.Client, key string, selector string, data *T) error {
+	raw, err := JSONGet(ctx, r, key, selector)
 	if err != nil {
 		return err
 	}
 
-	return json.Unmarshal(jsonB, &data)
+	return JSONDecodeFirst(raw, data)
 }
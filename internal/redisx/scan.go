@@ -0,0 +1,72 @@
+package redisx
+
+import (
+	"context"
+	"slices"
+	"strings"
+
+	"github.com/redis/go-redis/v9"
+)
+
+// scanCount is the COUNT hint given to SCAN, it is also the UNLINK batch size.
+const scanCount = 500
+
+var globEscaper = strings.NewReplacer(
+	`\`, `\\`,
+	`*`, `\*`,
+	`?`, `\?`,
+	`[`, `\[`,
+	`]`, `\]`,
+)
+
+// EscapeGlob will escape the glob-style pattern characters used by SCAN MATCH.
+func EscapeGlob(s string) string {
+	return globEscaper.Replace(s)
+}
+
+// DeletePrefix will delete every key starting with prefix.
+//
+// Keys are found with SCAN rather than KEYS so large keyspaces do not block the
+// server, and are removed with UNLINK in batches.
+func DeletePrefix(ctx context.Context, r *redis.Client, prefix string) (int, error) {
+	return DeletePattern(ctx, r, EscapeGlob(prefix)+"*")
+}
+
+// DeletePattern will delete every key matching the SCAN MATCH pattern match.
+func DeletePattern(ctx context.Context, r *redis.Client, match string) (int, error) {
+	return DeletePatternFunc(ctx, r, match, nil)
+}
+
+// DeletePatternFunc is DeletePattern for the keys del returns true for, a nil
+// del deletes every matching key. It is used where a glob cannot anchor a key.
+func DeletePatternFunc(ctx context.Context, r *redis.Client, match string, del func(key string) bool) (int, error) {
+	var (
+		cursor  uint64
+		deleted int
+	)
+
+	for {
+		keys, next, err := r.Scan(ctx, cursor, match, scanCount).Result()
+		if err != nil {
+			return deleted, err
+		}
+
+		if del != nil {
+			keys = slices.DeleteFunc(keys, func(key string) bool { return !del(key) })
+		}
+
+		if len(keys) > 0 {
+			n, err := r.Unlink(ctx, keys...).Result()
+			if err != nil {
+				return deleted, err
+			}
+
+			deleted += int(n)
+		}
+
+		cursor = next
+		if cursor == 0 {
+			return deleted, nil
+		}
+	}
+}
@@ -3,11 +3,15 @@ package jikan
 import (
 	"context"
 	"encoding/json"
+	"log/slog"
 	"net/http"
 	"net/url"
+	"slices"
+	"time"
 
 	"github.com/minnasync/jikan-go/internal/httpx"
 	"github.com/redis/go-redis/v9"
+	"golang.org/x/time/rate"
 )
 
 type Response struct {
@@ -19,7 +23,15 @@ type Client struct {
 	client  *http.Client
 	baseUrl *url.URL
 
-	cache Caches
+	cache   Caches
+	limiter *rate.Limiter
+
+	interceptors []Interceptor
+	handler      RequestHandler
+
+	logger    *slog.Logger
+	logLevels LogLevels
+	metrics   *clientMetrics
 
 	common  service
 	Anime   *AnimeEndpoints
@@ -33,15 +45,32 @@ type service struct {
 
 type ClientOption func(*Client)
 
+// WithCache will use cache instead of the default in-memory cache.
+func WithCache(cache Caches) ClientOption {
+	return func(c *Client) {
+		c.cache = cache
+	}
+}
+
+// WithRateLimiter will throttle requests with limiter instead of the limiter
+// shared by every client. Jikan limits requests per IP, so only replace it for
+// a different upstream or in tests, e.g. rate.NewLimiter(rate.Inf, 0).
+func WithRateLimiter(limiter *rate.Limiter) ClientOption {
+	return func(c *Client) {
+		c.limiter = limiter
+	}
+}
+
 // WithRedisCache will enable redis caching.
-func WithRedisCache(client *redis.Client) ClientOption {
+func WithRedisCache(client *redis.Client, opts ...CacheOption) ClientOption {
 	return func(c *Client) {
-		c.cache = NewRedisJSONCache(client)
+		c.cache = NewRedisJSONCache(client, opts...)
 	}
 }
 
 func (c *Client) newClient() *Client {
 	c.common.client = c
+	c.handler = c.buildHandler()
 
 	c.Anime = (*AnimeEndpoints)(&c.common)
 	c.Seasons = (*SeasonsEndpoints)(&c.common)
@@ -64,16 +93,37 @@ func NewJikanClient(options ...ClientOption) *Client {
 			Scheme: "https",
 			Host:   "api.jikan.moe",
 		},
-		cache: NewCache(),
+		cache:     NewCache(),
+		limiter:   httpx.Limiter,
+		logLevels: defaultLogLevels,
 	}
 
 	for _, option := range options {
 		option(c)
 	}
 
+	if c.limiter == nil {
+		c.limiter = httpx.Limiter
+	}
+	ratelimit.Limiter = c.limiter
+
 	return c.newClient()
 }
 
+type forceRefreshKey struct{}
+
+// ForceRefresh will return a context that makes endpoints skip reading the cache.
+//
+// Responses are still written to the cache, replacing any existing entries.
+func ForceRefresh(ctx context.Context) context.Context {
+	return context.WithValue(ctx, forceRefreshKey{}, true)
+}
+
+// readCache will report whether an endpoint should look up the cache before requesting.
+func (c *Client) readCache(ctx context.Context) bool {
+	return c.cache != nil && ctx.Value(forceRefreshKey{}) == nil
+}
+
 // NewGETRequest will create a new GET request only.
 //
 // Jikan only supports GET requests, refer to documentation.
@@ -92,17 +142,58 @@ func (c *Client) NewGETRequest(path string) (*http.Request, error) {
 	return req, nil
 }
 
-// Do will execute an HTTP request.
+// withQuery will append a normalized query string to path.
+//
+// Keys and the values of each key are sorted so equivalent queries produce the
+// same path, which is also used as the cache key for list responses.
+func withQuery(path string, query *url.Values) string {
+	if query == nil || len(*query) == 0 {
+		return path
+	}
+
+	values := make(url.Values, len(*query))
+	for key, value := range *query {
+		sorted := slices.Clone(value)
+		slices.Sort(sorted)
+		values[key] = sorted
+	}
+
+	return path + "?" + values.Encode()
+}
+
+// Do will execute an HTTP request through the interceptor chain.
+//
+// Responses with a non-2xx status code are returned as an *ErrorResponse. When
+// the body can not be decoded into v the response is returned with the error.
 func (c *Client) Do(ctx context.Context, req *http.Request, v any) (*http.Response, error) {
-	resp, err := c.client.Do(req.WithContext(ctx))
+	operation, _ := OperationFromContext(ctx)
+
+	stats := new(httpx.Stats)
+	start := time.Now()
+
+	resp, err := c.do(&Request{Operation: operation, Request: req.WithContext(httpx.WithStats(ctx, stats))}, v)
+	c.observeRequest(ctx, req, resp, time.Since(start), stats.LimiterWait, err)
+
+	return resp, err
+}
+
+func (c *Client) do(req *Request, v any) (*http.Response, error) {
+	resp, err := c.handler(req)
 	if err != nil {
 		return nil, err
 	}
 	defer resp.Body.Close()
 
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		errResp := &ErrorResponse{Response: resp}
+		_ = json.NewDecoder(resp.Body).Decode(errResp)
+
+		return resp, errResp
+	}
+
 	if v != nil {
 		if err := json.NewDecoder(resp.Body).Decode(v); err != nil {
-			return nil, err
+			return resp, err
 		}
 	}
 
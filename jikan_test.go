@@ -0,0 +1,204 @@
+package jikan
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/minnasync/jikan-go/metrics"
+	"golang.org/x/time/rate"
+)
+
+// newTestClient will create a client that sends every request to handler.
+func newTestClient(t *testing.T, handler http.Handler, options ...ClientOption) *Client {
+	t.Helper()
+
+	server := httptest.NewServer(handler)
+	t.Cleanup(server.Close)
+
+	// Tests are not throttled, options can still replace the limiter.
+	options = append([]ClientOption{WithRateLimiter(rate.NewLimiter(rate.Inf, 0))}, options...)
+	client := NewJikanClient(options...)
+
+	u, err := url.Parse(server.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	client.baseUrl = u
+
+	return client
+}
+
+func TestWithQuery(t *testing.T) {
+	a := &url.Values{"page": {"2"}, "filter": {"tv", "movie"}}
+	b := &url.Values{"filter": {"movie", "tv"}, "page": {"2"}}
+
+	if withQuery("/v4/seasons/now", a) != withQuery("/v4/seasons/now", b) {
+		t.Fatalf("expected equal paths, got %q and %q", withQuery("/v4/seasons/now", a), withQuery("/v4/seasons/now", b))
+	}
+
+	if path := withQuery("/v4/seasons/now", nil); path != "/v4/seasons/now" {
+		t.Fatalf("unexpected path for nil query: %q", path)
+	}
+
+	if path := withQuery("/v4/seasons/now", &url.Values{}); path != "/v4/seasons/now" {
+		t.Fatalf("unexpected path for empty query: %q", path)
+	}
+}
+
+func TestInterceptors(t *testing.T) {
+	var calls []string
+	record := func(name string) Interceptor {
+		return func(next RequestHandler) RequestHandler {
+			return func(req *Request) (*http.Response, error) {
+				calls = append(calls, name+":"+req.Operation)
+				req.Request.Header.Set("X-Interceptor", name)
+
+				return next(req)
+			}
+		}
+	}
+
+	// Fails every request for anime 2 without reaching the server.
+	fault := func(next RequestHandler) RequestHandler {
+		return func(req *Request) (*http.Response, error) {
+			if req.Request.URL.Path == "/v4/anime/2" {
+				return &http.Response{
+					StatusCode: http.StatusServiceUnavailable,
+					Body:       io.NopCloser(strings.NewReader(`{"status":503,"message":"injected"}`)),
+				}, nil
+			}
+
+			return next(req)
+		}
+	}
+
+	client := newTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("X-Interceptor") != "inner" {
+			t.Errorf("expected header from the inner interceptor, got %q", r.Header.Get("X-Interceptor"))
+		}
+
+		_, _ = w.Write([]byte(`{"data":{"mal_id":1}}`))
+	}), WithInterceptors(record("outer"), record("inner"), fault))
+
+	if _, _, err := client.Anime.GetById(t.Context(), "1"); err != nil {
+		t.Fatal(err)
+	}
+
+	if !slices.Equal(calls, []string{"outer:anime.getById", "inner:anime.getById"}) {
+		t.Fatalf("unexpected calls: %v", calls)
+	}
+
+	var errResp *ErrorResponse
+	if _, _, err := client.Anime.GetByMalID(t.Context(), 2); !errors.As(err, &errResp) || errResp.Message != "injected" {
+		t.Fatalf("expected the injected error, got %v", err)
+	}
+}
+
+func TestLogger(t *testing.T) {
+	var buf bytes.Buffer
+	logger := slog.New(slog.NewJSONHandler(&buf, &slog.HandlerOptions{Level: slog.LevelDebug}))
+
+	client := newTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"data":{"mal_id":1}}`))
+	}), WithLogger(logger))
+
+	if _, _, err := client.Anime.GetByMalID(t.Context(), 1); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := client.cache.Anime().SetAnime(t.Context(), Anime{MalID: 2}); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, _, err := client.Anime.GetByMalID(t.Context(), 2); err != nil {
+		t.Fatal(err)
+	}
+
+	var records []map[string]any
+	for line := range strings.Lines(buf.String()) {
+		var record map[string]any
+		if err := json.Unmarshal([]byte(line), &record); err != nil {
+			t.Fatal(err)
+		}
+
+		records = append(records, record)
+	}
+
+	if len(records) != 2 {
+		t.Fatalf("expected 2 records, got %d:\n%s", len(records), buf.String())
+	}
+
+	if records[0]["operation"] != OperationAnimeGetById || records[0]["path"] != "/v4/anime/1" ||
+		records[0]["status"] != float64(200) || records[0]["cache"] != "miss" {
+		t.Fatalf("unexpected request record: %v", records[0])
+	}
+
+	if records[1]["cache"] != "hit" || records[1]["path"] != "/v4/anime/2" {
+		t.Fatalf("unexpected cache record: %v", records[1])
+	}
+}
+
+func TestMetrics(t *testing.T) {
+	registry := metrics.NewRegistry()
+
+	client := newTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"data":{"mal_id":1}}`))
+	}), WithMetrics(registry))
+
+	if err := client.cache.Anime().SetAnime(t.Context(), Anime{MalID: 2}); err != nil {
+		t.Fatal(err)
+	}
+
+	for _, id := range []AnimeID{1, 2} {
+		if _, _, err := client.Anime.GetByMalID(t.Context(), id); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	var buf bytes.Buffer
+	if err := registry.Write(&buf); err != nil {
+		t.Fatal(err)
+	}
+
+	for _, expected := range []string{
+		`jikan_requests_total{operation="anime.getById",status="200"} 1`,
+		`jikan_request_duration_seconds_count{operation="anime.getById"} 1`,
+		`jikan_limiter_wait_seconds_count{operation="anime.getById"} 1`,
+		`jikan_cache_lookups_total{operation="anime.getById",result="hit"} 1`,
+		`jikan_cache_lookups_total{operation="anime.getById",result="miss"} 1`,
+	} {
+		if !strings.Contains(buf.String(), expected) {
+			t.Fatalf("expected %q in:\n%s", expected, buf.String())
+		}
+	}
+}
+
+func TestDoDecodeError(t *testing.T) {
+	client := newTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"data":`))
+	}))
+
+	req, err := client.NewGETRequest("/v4/anime/1")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var body ResponseBody[Anime]
+	resp, err := client.Do(t.Context(), req, &body)
+	if err == nil {
+		t.Fatal("expected a decode error")
+	}
+
+	if resp == nil || resp.StatusCode != http.StatusOK {
+		t.Fatalf("expected the response with the decode error, got %v", resp)
+	}
+}
@@ -0,0 +1,115 @@
+package jikan
+
+import (
+	"context"
+	"log/slog"
+	"net/http"
+	"time"
+)
+
+// LogLevels are the levels a client logs at.
+type LogLevels struct {
+	// Request is used for requests sent to Jikan that succeeded.
+	Request slog.Level
+	// Cache is used for responses served from Caches.
+	Cache slog.Level
+	// Error is used for failed requests and failed cache writes.
+	Error slog.Level
+}
+
+// defaultLogLevels keeps successful traffic out of Info logs.
+var defaultLogLevels = LogLevels{
+	Request: slog.LevelDebug,
+	Cache:   slog.LevelDebug,
+	Error:   slog.LevelWarn,
+}
+
+// WithLogger will log every request and cache lookup made by an endpoint, and
+// errors from the cache writes made in the background after a request.
+//
+// Requests are logged with their operation, path, status, latency, cache result
+// and time spent waiting on the rate limiter. Levels default to Debug for
+// requests and cache hits and Warn for errors, use WithLogLevels to change them.
+//
+// The client does not retry requests, so every request is logged exactly once
+// and there are no retry attempts to log.
+func WithLogger(logger *slog.Logger) ClientOption {
+	return func(c *Client) {
+		c.logger = logger
+	}
+}
+
+// WithLogLevels will set the levels used by WithLogger.
+func WithLogLevels(levels LogLevels) ClientOption {
+	return func(c *Client) {
+		c.logLevels = levels
+	}
+}
+
+// logCacheHit will log a response served from the cache.
+func (c *Client) logCacheHit(ctx context.Context, path string) {
+	if c.logger == nil {
+		return
+	}
+
+	operation, _ := OperationFromContext(ctx)
+	c.logger.LogAttrs(ctx, c.logLevels.Cache, "jikan: cache hit",
+		slog.String("operation", operation),
+		slog.String("path", path),
+		slog.String("cache", "hit"),
+	)
+}
+
+// logRequest will log a request sent to Jikan.
+func (c *Client) logRequest(ctx context.Context, req *http.Request, resp *http.Response, latency, wait time.Duration, err error) {
+	if c.logger == nil {
+		return
+	}
+
+	level := c.logLevels.Request
+	if err != nil {
+		level = c.logLevels.Error
+	}
+
+	operation, _ := OperationFromContext(ctx)
+	attrs := []slog.Attr{
+		slog.String("operation", operation),
+		slog.String("path", req.URL.RequestURI()),
+		slog.Duration("latency", latency),
+		slog.Duration("limiter_wait", wait),
+	}
+
+	if operation != "" {
+		cache := "miss"
+		if !c.readCache(ctx) {
+			cache = "bypass"
+		}
+
+		attrs = append(attrs, slog.String("cache", cache))
+	}
+
+	if resp != nil {
+		attrs = append(attrs, slog.Int("status", resp.StatusCode))
+	}
+
+	if err != nil {
+		attrs = append(attrs, slog.Any("error", err))
+	}
+
+	c.logger.LogAttrs(ctx, level, "jikan: request", attrs...)
+}
+
+// logCacheWrite will log err from a background cache write, the only place
+// these errors are reported.
+func (c *Client) logCacheWrite(ctx context.Context, op string, err error) {
+	if c.logger == nil || err == nil {
+		return
+	}
+
+	operation, _ := OperationFromContext(ctx)
+	c.logger.LogAttrs(ctx, c.logLevels.Error, "jikan: cache write failed",
+		slog.String("operation", operation),
+		slog.String("cache_op", op),
+		slog.Any("error", err),
+	)
+}
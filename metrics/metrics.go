@@ -0,0 +1,160 @@
+// Package metrics is a small metrics registry with a Prometheus text format handler.
+//
+// The client only depends on the Registry interface, so it can be backed by
+// another metrics library instead of the built-in registry.
+package metrics
+
+import (
+	"fmt"
+	"math"
+	"slices"
+	"strings"
+	"sync"
+)
+
+// DefaultBuckets are histogram buckets in seconds, suited to HTTP latencies.
+var DefaultBuckets = []float64{.005, .01, .025, .05, .1, .25, .5, 1, 2.5, 5, 10}
+
+// Counter is a value that only goes up.
+type Counter interface {
+	// Add will add v to the series with labelValues, given in the order of the labels.
+	Add(v float64, labelValues ...string)
+}
+
+// Histogram counts observations into buckets.
+type Histogram interface {
+	// Observe will add v to the series with labelValues, given in the order of the labels.
+	Observe(v float64, labelValues ...string)
+}
+
+// Registry creates metrics. Asking for a name twice returns the same metric.
+type Registry interface {
+	Counter(name, help string, labels ...string) Counter
+	Histogram(name, help string, buckets []float64, labels ...string) Histogram
+}
+
+// DefaultRegistry is an in-memory Registry, it is an http.Handler serving every
+// metric in the Prometheus text format.
+type DefaultRegistry struct {
+	mu      sync.Mutex
+	metrics map[string]*metric
+}
+
+// NewRegistry will create an empty registry.
+func NewRegistry() *DefaultRegistry {
+	return &DefaultRegistry{metrics: make(map[string]*metric)}
+}
+
+type metricKind string
+
+const (
+	kindCounter   metricKind = "counter"
+	kindHistogram metricKind = "histogram"
+)
+
+type metric struct {
+	mu      sync.Mutex
+	name    string
+	help    string
+	kind    metricKind
+	labels  []string
+	buckets []float64
+	series  map[string]*series
+}
+
+type series struct {
+	labelValues []string
+	// value is the total of a counter, or the sum of a histogram.
+	value float64
+	count uint64
+	// buckets are the non-cumulative counts of a histogram, the last one is +Inf.
+	buckets []uint64
+}
+
+// Counter will return the counter called name, creating it on first use.
+// It panics when name is already used by a histogram.
+func (r *DefaultRegistry) Counter(name, help string, labels ...string) Counter {
+	return r.register(name, help, kindCounter, nil, labels)
+}
+
+// Histogram will return the histogram called name, creating it on first use.
+// Buckets are upper bounds, DefaultBuckets are used when none are given.
+// It panics when name is already used by a counter.
+func (r *DefaultRegistry) Histogram(name, help string, buckets []float64, labels ...string) Histogram {
+	if len(buckets) == 0 {
+		buckets = DefaultBuckets
+	}
+
+	buckets = slices.Clone(buckets)
+	slices.Sort(buckets)
+
+	return r.register(name, help, kindHistogram, buckets, labels)
+}
+
+func (r *DefaultRegistry) register(name, help string, kind metricKind, buckets []float64, labels []string) *metric {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if m, ok := r.metrics[name]; ok {
+		if m.kind != kind {
+			panic(fmt.Sprintf("metrics: %s is already registered as a %s", name, m.kind))
+		}
+
+		return m
+	}
+
+	m := &metric{
+		name:    name,
+		help:    help,
+		kind:    kind,
+		labels:  slices.Clone(labels),
+		buckets: buckets,
+		series:  make(map[string]*series),
+	}
+	r.metrics[name] = m
+
+	return m
+}
+
+// get will return the series for labelValues, missing values are left empty.
+// The caller must hold m.mu.
+func (m *metric) get(labelValues []string) *series {
+	values := make([]string, len(m.labels))
+	copy(values, labelValues)
+
+	key := strings.Join(values, "\xff")
+	s, ok := m.series[key]
+	if !ok {
+		s = &series{labelValues: values}
+		if m.kind == kindHistogram {
+			s.buckets = make([]uint64, len(m.buckets)+1)
+		}
+
+		m.series[key] = s
+	}
+
+	return s
+}
+
+func (m *metric) Add(v float64, labelValues ...string) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.get(labelValues).value += v
+}
+
+func (m *metric) Observe(v float64, labelValues ...string) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	s := m.get(labelValues)
+	s.value += v
+	s.count++
+
+	i, _ := slices.BinarySearch(m.buckets, v)
+	if math.IsNaN(v) {
+		i = len(m.buckets)
+	}
+
+	s.buckets[i]++
+}
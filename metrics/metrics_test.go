@@ -0,0 +1,48 @@
+package metrics
+
+import (
+	"net/http/httptest"
+	"testing"
+)
+
+func TestRegistry(t *testing.T) {
+	registry := NewRegistry()
+
+	requests := registry.Counter("requests_total", "Requests sent.", "operation", "status")
+	requests.Add(1, "anime.getById", "200")
+	requests.Add(2, "anime.getById", "200")
+	requests.Add(1, "anime.get\"ById\"", "404")
+
+	latency := registry.Histogram("latency_seconds", "Request latency.", []float64{1, 0.1}, "operation")
+	latency.Observe(0.05, "anime.getById")
+	latency.Observe(0.1, "anime.getById")
+	latency.Observe(3, "anime.getById")
+
+	if registry.Counter("requests_total", "Requests sent.", "operation", "status") != requests {
+		t.Fatal("expected the registered counter to be returned")
+	}
+
+	rec := httptest.NewRecorder()
+	registry.ServeHTTP(rec, httptest.NewRequest("GET", "/metrics", nil))
+
+	expected := `# HELP latency_seconds Request latency.
+# TYPE latency_seconds histogram
+latency_seconds_bucket{operation="anime.getById",le="0.1"} 2
+latency_seconds_bucket{operation="anime.getById",le="1"} 2
+latency_seconds_bucket{operation="anime.getById",le="+Inf"} 3
+latency_seconds_sum{operation="anime.getById"} 3.15
+latency_seconds_count{operation="anime.getById"} 3
+# HELP requests_total Requests sent.
+# TYPE requests_total counter
+requests_total{operation="anime.get\"ById\"",status="404"} 1
+requests_total{operation="anime.getById",status="200"} 3
+`
+
+	if rec.Body.String() != expected {
+		t.Fatalf("unexpected output:\n%s", rec.Body.String())
+	}
+
+	if ct := rec.Header().Get("Content-Type"); ct != ContentType {
+		t.Fatalf("unexpected content type %q", ct)
+	}
+}
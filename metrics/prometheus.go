@@ -0,0 +1,118 @@
+package metrics
+
+import (
+	"bufio"
+	"io"
+	"math"
+	"net/http"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+// ContentType is the content type of the Prometheus text format.
+const ContentType = "text/plain; version=0.0.4; charset=utf-8"
+
+// ServeHTTP will write every metric in the Prometheus text format.
+func (r *DefaultRegistry) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	w.Header().Set("Content-Type", ContentType)
+	_ = r.Write(w)
+}
+
+// Write will write every metric in the Prometheus text format, sorted by name
+// and label values so the output is stable.
+func (r *DefaultRegistry) Write(w io.Writer) error {
+	r.mu.Lock()
+	metrics := make([]*metric, 0, len(r.metrics))
+	for _, m := range r.metrics {
+		metrics = append(metrics, m)
+	}
+	r.mu.Unlock()
+
+	slices.SortFunc(metrics, func(a, b *metric) int { return strings.Compare(a.name, b.name) })
+
+	bw := bufio.NewWriter(w)
+	for _, m := range metrics {
+		m.write(bw)
+	}
+
+	return bw.Flush()
+}
+
+func (m *metric) write(w *bufio.Writer) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	w.WriteString("# HELP " + m.name + " " + escapeHelp(m.help) + "\n")
+	w.WriteString("# TYPE " + m.name + " " + string(m.kind) + "\n")
+
+	all := make([]*series, 0, len(m.series))
+	for _, s := range m.series {
+		all = append(all, s)
+	}
+
+	slices.SortFunc(all, func(a, b *series) int { return slices.Compare(a.labelValues, b.labelValues) })
+
+	for _, s := range all {
+		if m.kind == kindCounter {
+			writeSample(w, m.name, m.labels, s.labelValues, "", s.value)
+			continue
+		}
+
+		var cumulative uint64
+		for i, count := range s.buckets {
+			cumulative += count
+
+			le := math.Inf(1)
+			if i < len(m.buckets) {
+				le = m.buckets[i]
+			}
+
+			writeSample(w, m.name+"_bucket", m.labels, s.labelValues, formatFloat(le), float64(cumulative))
+		}
+
+		writeSample(w, m.name+"_sum", m.labels, s.labelValues, "", s.value)
+		writeSample(w, m.name+"_count", m.labels, s.labelValues, "", float64(s.count))
+	}
+}
+
+// writeSample will write a single sample line, le is added as the last label when set.
+func writeSample(w *bufio.Writer, name string, labels, values []string, le string, value float64) {
+	w.WriteString(name)
+
+	pairs := make([]string, 0, len(labels)+1)
+	for i, label := range labels {
+		pairs = append(pairs, label+`="`+escapeLabel(values[i])+`"`)
+	}
+
+	if le != "" {
+		pairs = append(pairs, `le="`+le+`"`)
+	}
+
+	if len(pairs) > 0 {
+		w.WriteString("{" + strings.Join(pairs, ",") + "}")
+	}
+
+	w.WriteString(" " + formatFloat(value) + "\n")
+}
+
+func formatFloat(v float64) string {
+	switch {
+	case math.IsInf(v, 1):
+		return "+Inf"
+	case math.IsInf(v, -1):
+		return "-Inf"
+	case math.IsNaN(v):
+		return "NaN"
+	}
+
+	return strconv.FormatFloat(v, 'g', -1, 64)
+}
+
+func escapeHelp(s string) string {
+	return strings.NewReplacer(`\`, `\\`, "\n", `\n`).Replace(s)
+}
+
+func escapeLabel(s string) string {
+	return strings.NewReplacer(`\`, `\\`, "\n", `\n`, `"`, `\"`).Replace(s)
+}
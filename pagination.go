@@ -0,0 +1,166 @@
+package jikan
+
+import (
+	"context"
+	"iter"
+	"maps"
+	"net/url"
+	"strconv"
+
+	"golang.org/x/sync/errgroup"
+)
+
+const (
+	// defaultFetchWorkers matches the 3 requests per second Jikan allows.
+	defaultFetchWorkers = 3
+)
+
+// FetchAllOptions configure how the remaining pages of a list are fetched.
+type FetchAllOptions struct {
+	// Workers is the number of pages fetched at once, defaults to 3. Every worker
+	// shares the client's rate limit, so more workers only help while it has budget.
+	Workers int
+	// MaxPages stops fetching after this many pages when greater than zero.
+	MaxPages int
+}
+
+// paginate will lazily walk every page returned by fetch, starting from the page
+// in query or the first page.
+//
+// Iteration stops after maxItems items when it is greater than zero, on the first
+// error, or when ctx is cancelled. The caller's query is never modified.
+func paginate[T any](ctx context.Context, query *url.Values, maxItems int, fetch func(query *url.Values) (*PaginatedResponseBody[T], error)) iter.Seq2[T, error] {
+	return func(yield func(T, error) bool) {
+		var zero T
+
+		params := url.Values{}
+		if query != nil {
+			params = maps.Clone(*query)
+		}
+
+		page := 1
+		if p, err := strconv.Atoi(params.Get("page")); err == nil && p > 0 {
+			page = p
+		}
+
+		count := 0
+		for ; ; page++ {
+			if err := ctx.Err(); err != nil {
+				yield(zero, err)
+				return
+			}
+
+			params.Set("page", strconv.Itoa(page))
+
+			info, err := fetch(&params)
+			if err != nil {
+				yield(zero, err)
+				return
+			}
+
+			for _, item := range info.Data {
+				if !yield(item, nil) {
+					return
+				}
+
+				count++
+				if maxItems > 0 && count >= maxItems {
+					return
+				}
+			}
+
+			if !info.HasNextPage || len(info.Data) == 0 {
+				return
+			}
+		}
+	}
+}
+
+// fetchAll will fetch the page in query or the first page, then the remaining
+// pages up to LastVisiblePage concurrently. MaxPages counts from that page.
+//
+// Items are returned in page order. Jikan pages can shift while they are being
+// fetched, so an item seen on an earlier page is dropped from later ones.
+func fetchAll[T any](ctx context.Context, query *url.Values, opts *FetchAllOptions, key func(T) int, fetch func(ctx context.Context, query *url.Values) (*PaginatedResponseBody[T], error)) ([]T, error) {
+	workers := defaultFetchWorkers
+	maxPages := 0
+	if opts != nil {
+		if opts.Workers > 0 {
+			workers = opts.Workers
+		}
+
+		maxPages = opts.MaxPages
+	}
+
+	pageQuery := func(page int) *url.Values {
+		params := url.Values{}
+		if query != nil {
+			params = maps.Clone(*query)
+		}
+
+		params.Set("page", strconv.Itoa(page))
+		return &params
+	}
+
+	start := 1
+	if query != nil {
+		if p, err := strconv.Atoi(query.Get("page")); err == nil && p > 0 {
+			start = p
+		}
+	}
+
+	first, err := fetch(ctx, pageQuery(start))
+	if err != nil {
+		return nil, err
+	}
+
+	last := first.LastVisiblePage
+	if maxPages > 0 {
+		last = min(last, start+maxPages-1)
+	}
+
+	pages := make([][]T, max(last-start+1, 1))
+	pages[0] = first.Data
+
+	g, gctx := errgroup.WithContext(ctx)
+	g.SetLimit(workers)
+
+	for page := start + 1; page <= last; page++ {
+		g.Go(func() error {
+			info, err := fetch(gctx, pageQuery(page))
+			if err != nil {
+				return err
+			}
+
+			pages[page-start] = info.Data
+			return nil
+		})
+	}
+
+	if err := g.Wait(); err != nil {
+		return nil, err
+	}
+
+	seen := make(map[int]bool)
+	items := make([]T, 0, len(first.Data)*len(pages))
+	for _, page := range pages {
+		for _, item := range page {
+			if seen[key(item)] {
+				continue
+			}
+
+			seen[key(item)] = true
+			items = append(items, item)
+		}
+	}
+
+	return items, nil
+}
+
+func animeKey(anime Anime) int {
+	return anime.MalID
+}
+
+func episodeKey(episode Episode) int {
+	return episode.MalID
+}
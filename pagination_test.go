@@ -0,0 +1,104 @@
+package jikan
+
+import (
+	"context"
+	"net/url"
+	"slices"
+	"strconv"
+	"testing"
+)
+
+func TestPaginate(t *testing.T) {
+	query := &url.Values{"filter": {"tv"}}
+
+	var pages []string
+	fetch := func(query *url.Values) (*PaginatedResponseBody[Anime], error) {
+		page, _ := strconv.Atoi(query.Get("page"))
+		pages = append(pages, query.Get("page"))
+
+		return &PaginatedResponseBody[Anime]{
+			Data:       []Anime{{MalID: page*10 + 1}, {MalID: page*10 + 2}},
+			Pagination: Pagination{HasNextPage: page < 3},
+		}, nil
+	}
+
+	var ids []int
+	for anime, err := range paginate(t.Context(), query, 0, fetch) {
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		ids = append(ids, anime.MalID)
+	}
+
+	if len(ids) != 6 || ids[0] != 11 || ids[5] != 32 {
+		t.Fatalf("unexpected ids: %v", ids)
+	}
+
+	if query.Has("page") {
+		t.Fatal("caller's query was modified")
+	}
+
+	pages = nil
+	ids = nil
+	for anime, err := range paginate(t.Context(), query, 3, fetch) {
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		ids = append(ids, anime.MalID)
+	}
+
+	if len(ids) != 3 || len(pages) != 2 {
+		t.Fatalf("expected 3 items from 2 pages, got %v from %v", ids, pages)
+	}
+}
+
+func TestFetchAll(t *testing.T) {
+	fetch := func(ctx context.Context, query *url.Values) (*PaginatedResponseBody[Anime], error) {
+		page, _ := strconv.Atoi(query.Get("page"))
+
+		// Page 3 repeats the last item of page 2, as if the list shifted.
+		data := []Anime{{MalID: page*10 + 1}, {MalID: page*10 + 2}}
+		if page == 3 {
+			data[0].MalID = 22
+		}
+
+		return &PaginatedResponseBody[Anime]{
+			Data:       data,
+			Pagination: Pagination{LastVisiblePage: 4, HasNextPage: page < 4},
+		}, nil
+	}
+
+	items, err := fetchAll(t.Context(), nil, &FetchAllOptions{Workers: 2}, animeKey, fetch)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var ids []int
+	for _, item := range items {
+		ids = append(ids, item.MalID)
+	}
+
+	if !slices.Equal(ids, []int{11, 12, 21, 22, 32, 41, 42}) {
+		t.Fatalf("unexpected ids: %v", ids)
+	}
+
+	items, err = fetchAll(t.Context(), nil, &FetchAllOptions{MaxPages: 2}, animeKey, fetch)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(items) != 4 {
+		t.Fatalf("expected 4 items from 2 pages, got %d", len(items))
+	}
+
+	items, err = fetchAll(t.Context(), &url.Values{"page": {"3"}}, nil, animeKey, fetch)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(items) != 4 || items[0].MalID != 22 {
+		t.Fatalf("expected pages 3 and 4, got %+v", items)
+	}
+}
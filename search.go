@@ -0,0 +1,275 @@
+package jikan
+
+import (
+	"context"
+	"errors"
+	"net/url"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+)
+
+const (
+	// maxSearchLimit is the largest page size Jikan accepts.
+	maxSearchLimit = 25
+)
+
+// SearchAnimeType filters anime search results by type.
+type SearchAnimeType string
+
+const (
+	SearchAnimeTypeTV        SearchAnimeType = "tv"
+	SearchAnimeTypeMovie     SearchAnimeType = "movie"
+	SearchAnimeTypeOVA       SearchAnimeType = "ova"
+	SearchAnimeTypeSpecial   SearchAnimeType = "special"
+	SearchAnimeTypeONA       SearchAnimeType = "ona"
+	SearchAnimeTypeMusic     SearchAnimeType = "music"
+	SearchAnimeTypeCM        SearchAnimeType = "cm"
+	SearchAnimeTypePV        SearchAnimeType = "pv"
+	SearchAnimeTypeTVSpecial SearchAnimeType = "tv_special"
+)
+
+// SearchAnimeStatus filters anime search results by airing status.
+type SearchAnimeStatus string
+
+const (
+	SearchAnimeStatusAiring   SearchAnimeStatus = "airing"
+	SearchAnimeStatusComplete SearchAnimeStatus = "complete"
+	SearchAnimeStatusUpcoming SearchAnimeStatus = "upcoming"
+)
+
+// SearchAnimeRating filters anime search results by audience rating.
+type SearchAnimeRating string
+
+const (
+	SearchAnimeRatingG    SearchAnimeRating = "g"
+	SearchAnimeRatingPG   SearchAnimeRating = "pg"
+	SearchAnimeRatingPG13 SearchAnimeRating = "pg13"
+	SearchAnimeRatingR17  SearchAnimeRating = "r17"
+	SearchAnimeRatingR    SearchAnimeRating = "r"
+	SearchAnimeRatingRx   SearchAnimeRating = "rx"
+)
+
+// SearchAnimeOrderBy is the field anime search results are ordered by.
+type SearchAnimeOrderBy string
+
+const (
+	SearchAnimeOrderByMalID      SearchAnimeOrderBy = "mal_id"
+	SearchAnimeOrderByTitle      SearchAnimeOrderBy = "title"
+	SearchAnimeOrderByStartDate  SearchAnimeOrderBy = "start_date"
+	SearchAnimeOrderByEndDate    SearchAnimeOrderBy = "end_date"
+	SearchAnimeOrderByEpisodes   SearchAnimeOrderBy = "episodes"
+	SearchAnimeOrderByScore      SearchAnimeOrderBy = "score"
+	SearchAnimeOrderByScoredBy   SearchAnimeOrderBy = "scored_by"
+	SearchAnimeOrderByRank       SearchAnimeOrderBy = "rank"
+	SearchAnimeOrderByPopularity SearchAnimeOrderBy = "popularity"
+	SearchAnimeOrderByMembers    SearchAnimeOrderBy = "members"
+	SearchAnimeOrderByFavorites  SearchAnimeOrderBy = "favorites"
+)
+
+// SortDirection is the direction results are sorted in.
+type SortDirection string
+
+const (
+	SortAsc  SortDirection = "asc"
+	SortDesc SortDirection = "desc"
+)
+
+var (
+	searchAnimeTypes    = []SearchAnimeType{SearchAnimeTypeTV, SearchAnimeTypeMovie, SearchAnimeTypeOVA, SearchAnimeTypeSpecial, SearchAnimeTypeONA, SearchAnimeTypeMusic, SearchAnimeTypeCM, SearchAnimeTypePV, SearchAnimeTypeTVSpecial}
+	searchAnimeStatuses = []SearchAnimeStatus{SearchAnimeStatusAiring, SearchAnimeStatusComplete, SearchAnimeStatusUpcoming}
+	searchAnimeRatings  = []SearchAnimeRating{SearchAnimeRatingG, SearchAnimeRatingPG, SearchAnimeRatingPG13, SearchAnimeRatingR17, SearchAnimeRatingR, SearchAnimeRatingRx}
+	searchAnimeOrderBys = []SearchAnimeOrderBy{SearchAnimeOrderByMalID, SearchAnimeOrderByTitle, SearchAnimeOrderByStartDate, SearchAnimeOrderByEndDate, SearchAnimeOrderByEpisodes, SearchAnimeOrderByScore, SearchAnimeOrderByScoredBy, SearchAnimeOrderByRank, SearchAnimeOrderByPopularity, SearchAnimeOrderByMembers, SearchAnimeOrderByFavorites}
+	sortDirections      = []SortDirection{SortAsc, SortDesc}
+
+	// searchDatePattern matches the YYYY, YYYY-MM and YYYY-MM-DD formats Jikan accepts.
+	searchDatePattern = regexp.MustCompile(`^\d{4}(-(0[1-9]|1[0-2])(-(0[1-9]|[12]\d|3[01]))?)?$`)
+)
+
+// AnimeSearchQuery holds the parameters of an anime search, zero values are omitted.
+//
+// https://docs.api.jikan.moe/#/anime/getanimesearch
+type AnimeSearchQuery struct {
+	Query string
+
+	Type   SearchAnimeType
+	Status SearchAnimeStatus
+	Rating SearchAnimeRating
+	// SFW will exclude adult entries.
+	SFW bool
+
+	Score    *float64
+	MinScore *float64
+	MaxScore *float64
+
+	Genres        []int
+	GenresExclude []int
+	Producers     []int
+
+	OrderBy SearchAnimeOrderBy
+	Sort    SortDirection
+
+	// Letter will only return entries starting with this character.
+	Letter string
+
+	// StartDate and EndDate are formatted as YYYY, YYYY-MM or YYYY-MM-DD.
+	StartDate string
+	EndDate   string
+
+	Page  int
+	Limit int
+}
+
+// Validate will check the query against what Jikan accepts. Errors match ErrInvalidQuery
+// and are joined in the order of the fields.
+func (q AnimeSearchQuery) Validate() error {
+	var errs []error
+
+	if q.Type != "" && !slices.Contains(searchAnimeTypes, q.Type) {
+		errs = append(errs, invalidQuery("type", "%q is not a valid anime type", q.Type))
+	}
+
+	if q.Status != "" && !slices.Contains(searchAnimeStatuses, q.Status) {
+		errs = append(errs, invalidQuery("status", "%q is not a valid status", q.Status))
+	}
+
+	if q.Rating != "" && !slices.Contains(searchAnimeRatings, q.Rating) {
+		errs = append(errs, invalidQuery("rating", "%q is not a valid rating", q.Rating))
+	}
+
+	if q.OrderBy != "" && !slices.Contains(searchAnimeOrderBys, q.OrderBy) {
+		errs = append(errs, invalidQuery("order_by", "%q is not a valid field", q.OrderBy))
+	}
+
+	if q.Sort != "" && !slices.Contains(sortDirections, q.Sort) {
+		errs = append(errs, invalidQuery("sort", "%q is not a valid direction", q.Sort))
+	}
+
+	for _, f := range []struct {
+		param string
+		score *float64
+	}{{"score", q.Score}, {"min_score", q.MinScore}, {"max_score", q.MaxScore}} {
+		if f.score != nil && (*f.score < 0 || *f.score > 10) {
+			errs = append(errs, invalidQuery(f.param, "%v is not between 0 and 10", *f.score))
+		}
+	}
+
+	if q.MinScore != nil && q.MaxScore != nil && *q.MinScore > *q.MaxScore {
+		errs = append(errs, invalidQuery("min_score", "%v is greater than max_score %v", *q.MinScore, *q.MaxScore))
+	}
+
+	for _, f := range []struct {
+		param string
+		ids   []int
+	}{{"genres", q.Genres}, {"genres_exclude", q.GenresExclude}, {"producers", q.Producers}} {
+		for _, id := range f.ids {
+			if id <= 0 {
+				errs = append(errs, invalidQuery(f.param, "%d is not a valid id", id))
+			}
+		}
+	}
+
+	if q.Letter != "" && utf8.RuneCountInString(q.Letter) != 1 {
+		errs = append(errs, invalidQuery("letter", "%q must be a single character", q.Letter))
+	}
+
+	for _, f := range []struct {
+		param string
+		date  string
+	}{{"start_date", q.StartDate}, {"end_date", q.EndDate}} {
+		if f.date != "" && !searchDatePattern.MatchString(f.date) {
+			errs = append(errs, invalidQuery(f.param, "%q is not formatted as YYYY-MM-DD", f.date))
+		}
+	}
+
+	if q.Page < 0 {
+		errs = append(errs, invalidQuery("page", "%d is negative", q.Page))
+	}
+
+	if q.Limit < 0 || q.Limit > maxSearchLimit {
+		errs = append(errs, invalidQuery("limit", "%d is not between 1 and %d", q.Limit, maxSearchLimit))
+	}
+
+	return errors.Join(errs...)
+}
+
+// Values will validate the query and encode it as query parameters.
+func (q AnimeSearchQuery) Values() (url.Values, error) {
+	if err := q.Validate(); err != nil {
+		return nil, err
+	}
+
+	values := url.Values{}
+
+	set := func(param string, value string) {
+		if value != "" {
+			values.Set(param, value)
+		}
+	}
+
+	setScore := func(param string, score *float64) {
+		if score != nil {
+			values.Set(param, strconv.FormatFloat(*score, 'f', -1, 64))
+		}
+	}
+
+	setIDs := func(param string, ids []int) {
+		if len(ids) == 0 {
+			return
+		}
+
+		parts := make([]string, len(ids))
+		for i, id := range ids {
+			parts[i] = strconv.Itoa(id)
+		}
+
+		values.Set(param, strings.Join(parts, ","))
+	}
+
+	set("q", q.Query)
+	set("type", string(q.Type))
+	set("status", string(q.Status))
+	set("rating", string(q.Rating))
+	if q.SFW {
+		values.Set("sfw", "true")
+	}
+
+	setScore("score", q.Score)
+	setScore("min_score", q.MinScore)
+	setScore("max_score", q.MaxScore)
+
+	setIDs("genres", q.Genres)
+	setIDs("genres_exclude", q.GenresExclude)
+	setIDs("producers", q.Producers)
+
+	set("order_by", string(q.OrderBy))
+	set("sort", string(q.Sort))
+	set("letter", q.Letter)
+	set("start_date", q.StartDate)
+	set("end_date", q.EndDate)
+
+	if q.Page > 0 {
+		values.Set("page", strconv.Itoa(q.Page))
+	}
+
+	if q.Limit > 0 {
+		values.Set("limit", strconv.Itoa(q.Limit))
+	}
+
+	return values, nil
+}
+
+// Search will search for anime using a typed query, the query is validated
+// before any request is sent.
+//
+// https://docs.api.jikan.moe/#/anime/getanimesearch
+func (s *AnimeEndpoints) Search(ctx context.Context, query AnimeSearchQuery) (*PaginatedResponseBody[Anime], *Response, error) {
+	values, err := query.Values()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return s.GetSearch(ctx, query.Query, &values)
+}
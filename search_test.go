@@ -0,0 +1,56 @@
+package jikan
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestAnimeSearchQueryValues(t *testing.T) {
+	query := AnimeSearchQuery{
+		Query:     "frieren",
+		Type:      SearchAnimeTypeTV,
+		MinScore:  new(7.5),
+		Genres:    []int{1, 2},
+		OrderBy:   SearchAnimeOrderByScore,
+		Sort:      SortDesc,
+		StartDate: "2023-09",
+		Limit:     25,
+	}
+
+	values, err := query.Values()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected := "genres=1%2C2&limit=25&min_score=7.5&order_by=score&q=frieren&sort=desc&start_date=2023-09&type=tv"
+	if values.Encode() != expected {
+		t.Fatalf("unexpected values: %s", values.Encode())
+	}
+}
+
+func TestAnimeSearchQueryValidate(t *testing.T) {
+	query := AnimeSearchQuery{
+		OrderBy:   "order-by",
+		Limit:     26,
+		MinScore:  new(8.0),
+		MaxScore:  new(6.0),
+		StartDate: "2023/09/01",
+	}
+
+	err := query.Validate()
+	if !errors.Is(err, ErrInvalidQuery) {
+		t.Fatalf("expected ErrInvalidQuery, got %v", err)
+	}
+
+	if n := len(err.(interface{ Unwrap() []error }).Unwrap()); n != 4 {
+		t.Fatalf("expected 4 errors, got %d: %v", n, err)
+	}
+	// Errors are joined in field order, so the message is stable.
+	query.Score, query.MaxScore, query.EndDate = new(-1.0), new(11.0), "soon"
+	expected := query.Validate().Error()
+	for range 10 {
+		if msg := query.Validate().Error(); msg != expected {
+			t.Fatalf("unstable error message:\n%s\n%s", expected, msg)
+		}
+	}
+}
@@ -3,6 +3,7 @@ package jikan
 import (
 	"context"
 	"fmt"
+	"iter"
 	"net/url"
 )
 
@@ -13,16 +14,28 @@ type SeasonsEndpoints service
 //
 // https://docs.api.jikan.moe/#/seasons/getseasonnow
 func (s *SeasonsEndpoints) GetNow(ctx context.Context, query *url.Values) (*PaginatedResponseBody[Anime], *Response, error) {
-	info := new(PaginatedResponseBody[Anime])
+	ctx = withOperation(ctx, OperationSeasonsGetNow)
+
+	path := withQuery("/v4/seasons/now", query)
 
-	path := "/v4/seasons/now"
-	path += "?" + query.Encode()
+	if s.client.readCache(ctx) {
+		info, err := s.client.cache.Lists().GetAnimeList(ctx, path)
+		if err == nil {
+			s.client.observeCacheHit(ctx, path)
+
+			return info, &Response{
+				IsCached: true,
+				Response: nil,
+			}, nil
+		}
+	}
 
 	req, err := s.client.NewGETRequest(path)
 	if err != nil {
 		return nil, nil, err
 	}
 
+	info := new(PaginatedResponseBody[Anime])
 	resp, err := s.client.Do(ctx, req, info)
 	if err != nil {
 		return nil, &Response{
@@ -33,7 +46,8 @@ func (s *SeasonsEndpoints) GetNow(ctx context.Context, query *url.Values) (*Pagi
 
 	if s.client.cache != nil {
 		go func() {
-			_ = s.client.cache.Anime().BulkSetAnime(ctx, info.Data)
+			s.client.logCacheWrite(ctx, "BulkSetAnime", s.client.cache.Anime().BulkSetAnime(ctx, info.Data))
+			s.client.logCacheWrite(ctx, "SetAnimeList", s.client.cache.Lists().SetAnimeList(ctx, path, *info, seasonListTTL))
 		}()
 	}
 
@@ -45,18 +59,38 @@ func (s *SeasonsEndpoints) GetNow(ctx context.Context, query *url.Values) (*Pagi
 
 // Get will return the list of anime airing for a provided year + season.
 //
+// The season is matched case-insensitively with ParseAnimeSeason.
+//
 // https://docs.api.jikan.moe/#/seasons/getseason
-func (s *SeasonsEndpoints) Get(ctx context.Context, year int, season string, query *url.Values) (*PaginatedResponseBody[Anime], *Response, error) {
-	info := new(PaginatedResponseBody[Anime])
+func (s *SeasonsEndpoints) Get(ctx context.Context, year int, season AnimeSeason, query *url.Values) (*PaginatedResponseBody[Anime], *Response, error) {
+	ctx = withOperation(ctx, OperationSeasonsGet)
 
-	path := fmt.Sprintf("/v4/seasons/%d/%s", year, season)
-	path += "?" + query.Encode()
+	parsed, err := ParseAnimeSeason(string(season))
+	if err != nil {
+		return nil, nil, invalidQuery("season", "%q is not a valid season", season)
+	}
+	season = parsed
+
+	path := withQuery(fmt.Sprintf("/v4/seasons/%d/%s", year, season), query)
+
+	if s.client.readCache(ctx) {
+		info, err := s.client.cache.Lists().GetAnimeList(ctx, path)
+		if err == nil {
+			s.client.observeCacheHit(ctx, path)
+
+			return info, &Response{
+				IsCached: true,
+				Response: nil,
+			}, nil
+		}
+	}
 
 	req, err := s.client.NewGETRequest(path)
 	if err != nil {
 		return nil, nil, err
 	}
 
+	info := new(PaginatedResponseBody[Anime])
 	resp, err := s.client.Do(ctx, req, info)
 	if err != nil {
 		return nil, &Response{
@@ -67,7 +101,8 @@ func (s *SeasonsEndpoints) Get(ctx context.Context, year int, season string, que
 
 	if s.client.cache != nil {
 		go func() {
-			_ = s.client.cache.Anime().BulkSetAnime(ctx, info.Data)
+			s.client.logCacheWrite(ctx, "BulkSetAnime", s.client.cache.Anime().BulkSetAnime(ctx, info.Data))
+			s.client.logCacheWrite(ctx, "SetAnimeList", s.client.cache.Lists().SetAnimeList(ctx, path, *info, seasonListTTL))
 		}()
 	}
 
@@ -81,14 +116,28 @@ func (s *SeasonsEndpoints) Get(ctx context.Context, year int, season string, que
 //
 // https://docs.api.jikan.moe/#/seasons/getseasonslist
 func (s *SeasonsEndpoints) GetList(ctx context.Context) (*PaginatedResponseBody[Season], *Response, error) {
-	info := new(PaginatedResponseBody[Season])
+	ctx = withOperation(ctx, OperationSeasonsGetList)
+
 	path := "/v4/seasons"
 
+	if s.client.readCache(ctx) {
+		info, err := s.client.cache.Lists().GetSeasonList(ctx, path)
+		if err == nil {
+			s.client.observeCacheHit(ctx, path)
+
+			return info, &Response{
+				IsCached: true,
+				Response: nil,
+			}, nil
+		}
+	}
+
 	req, err := s.client.NewGETRequest(path)
 	if err != nil {
 		return nil, nil, err
 	}
 
+	info := new(PaginatedResponseBody[Season])
 	resp, err := s.client.Do(ctx, req, info)
 	if err != nil {
 		return nil, &Response{
@@ -97,6 +146,12 @@ func (s *SeasonsEndpoints) GetList(ctx context.Context) (*PaginatedResponseBody[
 		}, err
 	}
 
+	if s.client.cache != nil {
+		go func() {
+			s.client.logCacheWrite(ctx, "SetSeasonList", s.client.cache.Lists().SetSeasonList(ctx, path, *info, seasonsIndexTTL))
+		}()
+	}
+
 	return info, &Response{
 		IsCached: false,
 		Response: resp,
@@ -107,16 +162,28 @@ func (s *SeasonsEndpoints) GetList(ctx context.Context) (*PaginatedResponseBody[
 //
 // https://docs.api.jikan.moe/#/seasons/getseasonupcoming
 func (s *SeasonsEndpoints) GetUpcoming(ctx context.Context, query *url.Values) (*PaginatedResponseBody[Anime], *Response, error) {
-	info := new(PaginatedResponseBody[Anime])
+	ctx = withOperation(ctx, OperationSeasonsGetUpcoming)
+
+	path := withQuery("/v4/seasons/upcoming", query)
 
-	path := "/v4/seasons/upcoming"
-	path += "?" + query.Encode()
+	if s.client.readCache(ctx) {
+		info, err := s.client.cache.Lists().GetAnimeList(ctx, path)
+		if err == nil {
+			s.client.observeCacheHit(ctx, path)
+
+			return info, &Response{
+				IsCached: true,
+				Response: nil,
+			}, nil
+		}
+	}
 
 	req, err := s.client.NewGETRequest(path)
 	if err != nil {
 		return nil, nil, err
 	}
 
+	info := new(PaginatedResponseBody[Anime])
 	resp, err := s.client.Do(ctx, req, info)
 	if err != nil {
 		return nil, &Response{
@@ -127,7 +194,8 @@ func (s *SeasonsEndpoints) GetUpcoming(ctx context.Context, query *url.Values) (
 
 	if s.client.cache != nil {
 		go func() {
-			_ = s.client.cache.Anime().BulkSetAnime(ctx, info.Data)
+			s.client.logCacheWrite(ctx, "BulkSetAnime", s.client.cache.Anime().BulkSetAnime(ctx, info.Data))
+			s.client.logCacheWrite(ctx, "SetAnimeList", s.client.cache.Lists().SetAnimeList(ctx, path, *info, seasonListTTL))
 		}()
 	}
 
@@ -136,3 +204,58 @@ func (s *SeasonsEndpoints) GetUpcoming(ctx context.Context, query *url.Values) (
 		Response: resp,
 	}, nil
 }
+
+// AllNow will iterate over every anime airing in the current season, requesting pages as needed.
+// Iteration stops after maxItems anime when it is greater than zero.
+//
+// https://docs.api.jikan.moe/#/seasons/getseasonnow
+func (s *SeasonsEndpoints) AllNow(ctx context.Context, query *url.Values, maxItems int) iter.Seq2[Anime, error] {
+	return paginate(ctx, query, maxItems, func(query *url.Values) (*PaginatedResponseBody[Anime], error) {
+		info, _, err := s.GetNow(ctx, query)
+		return info, err
+	})
+}
+
+// All will iterate over every anime airing for a provided year + season, requesting pages as needed.
+// Iteration stops after maxItems anime when it is greater than zero.
+//
+// https://docs.api.jikan.moe/#/seasons/getseason
+func (s *SeasonsEndpoints) All(ctx context.Context, year int, season AnimeSeason, query *url.Values, maxItems int) iter.Seq2[Anime, error] {
+	return paginate(ctx, query, maxItems, func(query *url.Values) (*PaginatedResponseBody[Anime], error) {
+		info, _, err := s.Get(ctx, year, season, query)
+		return info, err
+	})
+}
+
+// AllUpcoming will iterate over every anime of the upcoming season, requesting pages as needed.
+// Iteration stops after maxItems anime when it is greater than zero.
+//
+// https://docs.api.jikan.moe/#/seasons/getseasonupcoming
+func (s *SeasonsEndpoints) AllUpcoming(ctx context.Context, query *url.Values, maxItems int) iter.Seq2[Anime, error] {
+	return paginate(ctx, query, maxItems, func(query *url.Values) (*PaginatedResponseBody[Anime], error) {
+		info, _, err := s.GetUpcoming(ctx, query)
+		return info, err
+	})
+}
+
+// FetchAll will fetch every page of anime airing for a provided year + season,
+// fetching pages after the first concurrently.
+//
+// https://docs.api.jikan.moe/#/seasons/getseason
+func (s *SeasonsEndpoints) FetchAll(ctx context.Context, year int, season AnimeSeason, query *url.Values, opts *FetchAllOptions) ([]Anime, error) {
+	return fetchAll(ctx, query, opts, animeKey, func(ctx context.Context, query *url.Values) (*PaginatedResponseBody[Anime], error) {
+		info, _, err := s.Get(ctx, year, season, query)
+		return info, err
+	})
+}
+
+// FetchAllNow will fetch every page of anime airing in the current season,
+// fetching pages after the first concurrently.
+//
+// https://docs.api.jikan.moe/#/seasons/getseasonnow
+func (s *SeasonsEndpoints) FetchAllNow(ctx context.Context, query *url.Values, opts *FetchAllOptions) ([]Anime, error) {
+	return fetchAll(ctx, query, opts, animeKey, func(ctx context.Context, query *url.Values) (*PaginatedResponseBody[Anime], error) {
+		info, _, err := s.GetNow(ctx, query)
+		return info, err
+	})
+}
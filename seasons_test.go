@@ -0,0 +1,25 @@
+package jikan
+
+import (
+	"errors"
+	"net/http"
+	"testing"
+)
+
+func TestSeasonsGetNormalizesSeason(t *testing.T) {
+	client := newTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/v4/seasons/2024/winter" {
+			t.Errorf("unexpected path %q", r.URL.Path)
+		}
+
+		_, _ = w.Write([]byte(`{"data":[]}`))
+	}))
+
+	if _, _, err := client.Seasons.Get(t.Context(), 2024, "Winter", nil); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, _, err := client.Seasons.Get(t.Context(), 2024, "monsoon", nil); !errors.Is(err, ErrInvalidQuery) {
+		t.Fatalf("expected ErrInvalidQuery, got %v", err)
+	}
+}
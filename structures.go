@@ -18,8 +18,8 @@ type Pagination struct {
 }
 
 type PaginatedResponseBody[T any] struct {
-	Data []T `json:"data"`
-	Pagination
+	Data       []T `json:"data"`
+	Pagination `json:"pagination"`
 }
 
 type Image struct {
@@ -35,19 +35,24 @@ type Trailer struct {
 }
 
 type Title struct {
-	Type  string `json:"type"`
-	Title string `json:"title"`
+	Type  TitleType `json:"type"`
+	Title string    `json:"title"`
 }
 
 type AiredInfo struct {
-	From string `json:"from"`
-	To   string `json:"to"`
+	From string    `json:"from"`
+	To   string    `json:"to"`
+	Prop AiredProp `json:"prop"`
+	// Text is the human readable range, e.g. "Apr 3, 1998 to Apr 24, 1999".
+	Text string `json:"string"`
 }
 
 type BroadcastInfo struct {
 	Day      string `json:"day"`
 	Time     string `json:"time"`
 	Timezone string `json:"timezone"`
+	// Text is the human readable slot, e.g. "Saturdays at 01:30 (JST)".
+	Text string `json:"string"`
 }
 
 type Entity struct {
@@ -58,8 +63,8 @@ type Entity struct {
 }
 
 type Relation struct {
-	Relation string   `json:"relation"`
-	Entry    []Entity `json:"entry"`
+	Relation RelationKind `json:"relation"`
+	Entry    []Entity     `json:"entry"`
 }
 
 type Theme struct {
@@ -73,6 +78,6 @@ type Link struct {
 }
 
 type Season struct {
-	Year    int      `json:"year"`
-	Seasons []string `json:"seasons"`
+	Year    int           `json:"year"`
+	Seasons []AnimeSeason `json:"seasons"`
 }
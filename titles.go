@@ -0,0 +1,127 @@
+package jikan
+
+import (
+	"strings"
+	"unicode"
+)
+
+// TitleType is the kind of a title, either a role such as Default and Synonym or
+// the language it is in.
+type TitleType string
+
+const (
+	TitleTypeDefault  TitleType = "Default"
+	TitleTypeSynonym  TitleType = "Synonym"
+	TitleTypeJapanese TitleType = "Japanese"
+	TitleTypeEnglish  TitleType = "English"
+	TitleTypeGerman   TitleType = "German"
+	TitleTypeSpanish  TitleType = "Spanish"
+	TitleTypeFrench   TitleType = "French"
+)
+
+// localeTitleTypes maps ISO 639-1 language codes to the title type MAL uses.
+var localeTitleTypes = map[string]TitleType{
+	"en": TitleTypeEnglish,
+	"ja": TitleTypeJapanese,
+	"de": TitleTypeGerman,
+	"es": TitleTypeSpanish,
+	"fr": TitleTypeFrench,
+}
+
+// TitleTypeForLocale will return the title type for a locale such as "de" or
+// "de-DE", it returns false for languages MAL has no titles for.
+func TitleTypeForLocale(locale string) (TitleType, bool) {
+	lang, _, _ := strings.Cut(strings.ToLower(locale), "-")
+	lang, _, _ = strings.Cut(lang, "_")
+
+	t, ok := localeTitleTypes[lang]
+	return t, ok
+}
+
+// title will return the first title of type t.
+func (a *Anime) title(t TitleType) string {
+	for _, title := range a.Titles {
+		if title.Type == t && title.Title != "" {
+			return title.Title
+		}
+	}
+
+	// Older responses only have the flat fields.
+	switch t {
+	case TitleTypeDefault:
+		return a.Title
+	case TitleTypeEnglish:
+		return a.TitleEN
+	case TitleTypeJapanese:
+		return a.TitleJP
+	case TitleTypeSynonym:
+		if len(a.TitleSynonyms) > 0 {
+			return a.TitleSynonyms[0]
+		}
+	}
+
+	return ""
+}
+
+// PreferredTitle will return the first available title in order of preference,
+// e.g. a user's locale, then English, then Default. It falls back to Title.
+func (a *Anime) PreferredTitle(preferences ...TitleType) string {
+	for _, t := range preferences {
+		if title := a.title(t); title != "" {
+			return title
+		}
+	}
+
+	return a.Title
+}
+
+// PreferredTitleForLocale will return the title for locale, falling back to the
+// English and then the default title.
+func (a *Anime) PreferredTitleForLocale(locale string) string {
+	if t, ok := TitleTypeForLocale(locale); ok {
+		return a.PreferredTitle(t, TitleTypeEnglish, TitleTypeDefault)
+	}
+
+	return a.PreferredTitle(TitleTypeEnglish, TitleTypeDefault)
+}
+
+// SearchTitles will return every known title normalized with NormalizeTitle,
+// without duplicates.
+func (a *Anime) SearchTitles() []string {
+	candidates := []string{a.Title, a.TitleEN, a.TitleJP}
+	candidates = append(candidates, a.TitleSynonyms...)
+	for _, title := range a.Titles {
+		candidates = append(candidates, title.Title)
+	}
+
+	seen := make(map[string]bool, len(candidates))
+	titles := make([]string, 0, len(candidates))
+	for _, candidate := range candidates {
+		normalized := NormalizeTitle(candidate)
+		if normalized == "" || seen[normalized] {
+			continue
+		}
+
+		seen[normalized] = true
+		titles = append(titles, normalized)
+	}
+
+	return titles
+}
+
+// NormalizeTitle will lowercase a title, replace punctuation with spaces and
+// collapse whitespace, so "Sousou no Frieren!" and "sousou no  frieren" match.
+func NormalizeTitle(title string) string {
+	mapped := strings.Map(func(r rune) rune {
+		switch {
+		case unicode.IsLetter(r), unicode.IsNumber(r):
+			return unicode.ToLower(r)
+		case unicode.IsMark(r):
+			return r
+		default:
+			return ' '
+		}
+	}, title)
+
+	return strings.Join(strings.Fields(mapped), " ")
+}
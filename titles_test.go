@@ -0,0 +1,28 @@
+package jikan
+
+import "testing"
+
+func TestPreferredTitle(t *testing.T) {
+	anime := Anime{
+		Title:   "Sousou no Frieren",
+		TitleEN: "Frieren: Beyond Journey's End",
+		Titles: []Title{
+			{Type: TitleTypeDefault, Title: "Sousou no Frieren"},
+			{Type: TitleTypeGerman, Title: "Frieren - Nach dem Ende der Reise"},
+		},
+		TitleSynonyms: []string{"SOUSOU NO FRIEREN!"},
+	}
+
+	if title := anime.PreferredTitleForLocale("de-DE"); title != "Frieren - Nach dem Ende der Reise" {
+		t.Fatalf("unexpected German title: %q", title)
+	}
+
+	if title := anime.PreferredTitleForLocale("fr"); title != "Frieren: Beyond Journey's End" {
+		t.Fatalf("expected English fallback, got %q", title)
+	}
+
+	titles := anime.SearchTitles()
+	if len(titles) != 3 || titles[0] != "sousou no frieren" {
+		t.Fatalf("unexpected search titles: %q", titles)
+	}
+}
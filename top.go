@@ -2,6 +2,7 @@ package jikan
 
 import (
 	"context"
+	"iter"
 	"net/url"
 )
 
@@ -12,16 +13,28 @@ type TopEndpoints service
 //
 // https://docs.api.jikan.moe/#/top/gettopanime
 func (s *TopEndpoints) GetTopAnime(ctx context.Context, query *url.Values) (*PaginatedResponseBody[Anime], *Response, error) {
-	info := new(PaginatedResponseBody[Anime])
+	ctx = withOperation(ctx, OperationTopGetTopAnime)
+
+	path := withQuery("/v4/top/anime", query)
 
-	path := "/v4/top/anime"
-	path += "?" + query.Encode()
+	if s.client.readCache(ctx) {
+		info, err := s.client.cache.Lists().GetAnimeList(ctx, path)
+		if err == nil {
+			s.client.observeCacheHit(ctx, path)
+
+			return info, &Response{
+				IsCached: true,
+				Response: nil,
+			}, nil
+		}
+	}
 
 	req, err := s.client.NewGETRequest(path)
 	if err != nil {
 		return nil, nil, err
 	}
 
+	info := new(PaginatedResponseBody[Anime])
 	resp, err := s.client.Do(ctx, req, info)
 	if err != nil {
 		return nil, &Response{
@@ -32,7 +45,8 @@ func (s *TopEndpoints) GetTopAnime(ctx context.Context, query *url.Values) (*Pag
 
 	if s.client.cache != nil {
 		go func() {
-			_ = s.client.cache.Anime().BulkSetAnime(ctx, info.Data)
+			s.client.logCacheWrite(ctx, "BulkSetAnime", s.client.cache.Anime().BulkSetAnime(ctx, info.Data))
+			s.client.logCacheWrite(ctx, "SetAnimeList", s.client.cache.Lists().SetAnimeList(ctx, path, *info, topListTTL))
 		}()
 	}
 
@@ -41,3 +55,25 @@ func (s *TopEndpoints) GetTopAnime(ctx context.Context, query *url.Values) (*Pag
 		Response: resp,
 	}, nil
 }
+
+// AllAnime will iterate over the top anime, requesting pages as needed.
+// Iteration stops after maxItems anime when it is greater than zero.
+//
+// https://docs.api.jikan.moe/#/top/gettopanime
+func (s *TopEndpoints) AllAnime(ctx context.Context, query *url.Values, maxItems int) iter.Seq2[Anime, error] {
+	return paginate(ctx, query, maxItems, func(query *url.Values) (*PaginatedResponseBody[Anime], error) {
+		info, _, err := s.GetTopAnime(ctx, query)
+		return info, err
+	})
+}
+
+// FetchAllAnime will fetch every page of the top anime, fetching pages after the
+// first concurrently. Results are in rank order without duplicates.
+//
+// https://docs.api.jikan.moe/#/top/gettopanime
+func (s *TopEndpoints) FetchAllAnime(ctx context.Context, query *url.Values, opts *FetchAllOptions) ([]Anime, error) {
+	return fetchAll(ctx, query, opts, animeKey, func(ctx context.Context, query *url.Values) (*PaginatedResponseBody[Anime], error) {
+		info, _, err := s.GetTopAnime(ctx, query)
+		return info, err
+	})
+}
@@ -0,0 +1,190 @@
+package jikan
+
+import (
+	"context"
+	"errors"
+	"iter"
+	"slices"
+	"sync/atomic"
+	"time"
+)
+
+const (
+	// defaultRefreshBefore is how long before expiry the warmer refreshes entries.
+	defaultRefreshBefore = time.Hour
+	// minRefreshInterval stops a large RefreshBefore from refreshing in a tight loop.
+	minRefreshInterval = time.Minute
+)
+
+// WarmSeason is a season whose anime are warmed.
+type WarmSeason struct {
+	Year   int
+	Season AnimeSeason
+}
+
+// WarmerOptions configure which resources a Warmer keeps cached.
+type WarmerOptions struct {
+	// IDs are the anime to warm.
+	IDs []AnimeID
+	// Seasons are warmed along with every anime in them.
+	Seasons []WarmSeason
+	// CurrentSeason will also warm the current season and every anime in it.
+	CurrentSeason bool
+
+	// RefreshBefore is how long before cached entries expire a new pass starts.
+	// Defaults to one hour.
+	RefreshBefore time.Duration
+
+	// OnProgress is called after every anime is warmed, it must be safe to call
+	// from the goroutine running the warmer.
+	OnProgress func(WarmProgress)
+}
+
+// WarmProgress reports the state of a warming pass.
+type WarmProgress struct {
+	// Pass is the number of the current pass, starting at 1.
+	Pass  int
+	Done  int
+	Total int
+
+	// ID is the anime that was just warmed, Err is set if it failed.
+	ID  AnimeID
+	Err error
+}
+
+// Warmer proactively fetches anime and their episodes so they are cached before
+// they are requested.
+//
+// All requests go through the client, so they share its rate limit and caches.
+type Warmer struct {
+	client *Client
+	opts   WarmerOptions
+	pass   atomic.Int64
+}
+
+// NewWarmer will create a warmer for the client.
+func (c *Client) NewWarmer(opts WarmerOptions) *Warmer {
+	if opts.RefreshBefore <= 0 {
+		opts.RefreshBefore = defaultRefreshBefore
+	}
+
+	return &Warmer{client: c, opts: opts}
+}
+
+// Interval is the time between passes made by Run.
+//
+// Passes are timed against the shortest TTL of the warmed list pages.
+func (w *Warmer) Interval() time.Duration {
+	return max(min(seasonListTTL, episodeListTTL)-w.opts.RefreshBefore, minRefreshInterval)
+}
+
+// Run will warm the cache, then refresh it before entries expire until ctx is cancelled.
+//
+// Errors for single anime are reported through OnProgress and do not stop the warmer.
+func (w *Warmer) Run(ctx context.Context) error {
+	ticker := time.NewTicker(w.Interval())
+	defer ticker.Stop()
+
+	for {
+		if err := w.Warm(ctx); err != nil && ctx.Err() != nil {
+			return ctx.Err()
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
+
+// Warm will run a single pass, fetching every configured anime and episode page
+// regardless of what is already cached.
+func (w *Warmer) Warm(ctx context.Context) error {
+	pass := int(w.pass.Add(1))
+	ctx = ForceRefresh(ctx)
+
+	ids, err := w.resolveIDs(ctx)
+	if err != nil {
+		return err
+	}
+
+	var errs []error
+	for i, id := range ids {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+
+		err := w.warmAnime(ctx, id)
+		if err != nil {
+			errs = append(errs, err)
+		}
+
+		if w.opts.OnProgress != nil {
+			w.opts.OnProgress(WarmProgress{
+				Pass:  pass,
+				Done:  i + 1,
+				Total: len(ids),
+				ID:    id,
+				Err:   err,
+			})
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+// resolveIDs will collect the configured IDs and those of every anime in the
+// configured seasons, without duplicates.
+func (w *Warmer) resolveIDs(ctx context.Context) ([]AnimeID, error) {
+	ids := slices.Clone(w.opts.IDs)
+
+	collect := func(seq iter.Seq2[Anime, error]) error {
+		for anime, err := range seq {
+			if err != nil {
+				return err
+			}
+
+			ids = append(ids, AnimeID(anime.MalID))
+		}
+
+		return nil
+	}
+
+	if w.opts.CurrentSeason {
+		if err := collect(w.client.Seasons.AllNow(ctx, nil, 0)); err != nil {
+			return nil, err
+		}
+	}
+
+	for _, season := range w.opts.Seasons {
+		if err := collect(w.client.Seasons.All(ctx, season.Year, season.Season, nil, 0)); err != nil {
+			return nil, err
+		}
+	}
+
+	seen := make(map[AnimeID]bool, len(ids))
+	return slices.DeleteFunc(ids, func(id AnimeID) bool {
+		if seen[id] {
+			return true
+		}
+
+		seen[id] = true
+		return false
+	}), nil
+}
+
+// warmAnime will fetch the full anime and every page of its episodes.
+func (w *Warmer) warmAnime(ctx context.Context, id AnimeID) error {
+	if _, _, err := w.client.Anime.GetFullByMalID(ctx, id); err != nil {
+		return err
+	}
+
+	for _, err := range w.client.Anime.AllEpisodes(ctx, id, nil, 0) {
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
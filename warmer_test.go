@@ -0,0 +1,49 @@
+package jikan
+
+import (
+	"net/http"
+	"sync/atomic"
+	"testing"
+)
+
+func TestWarmerWarm(t *testing.T) {
+	var requests atomic.Int32
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/v4/anime/1/full", func(w http.ResponseWriter, r *http.Request) {
+		requests.Add(1)
+		_, _ = w.Write([]byte(`{"data":{"mal_id":1}}`))
+	})
+	mux.HandleFunc("/v4/anime/1/episodes", func(w http.ResponseWriter, r *http.Request) {
+		requests.Add(1)
+
+		if r.URL.Query().Get("page") == "1" {
+			_, _ = w.Write([]byte(`{"data":[{"mal_id":1}],"pagination":{"has_next_page":true}}`))
+			return
+		}
+
+		_, _ = w.Write([]byte(`{"data":[{"mal_id":2}],"pagination":{"has_next_page":false}}`))
+	})
+
+	client := newTestClient(t, mux)
+
+	var progress []WarmProgress
+	warmer := client.NewWarmer(WarmerOptions{
+		IDs: []AnimeID{1, 1},
+		OnProgress: func(p WarmProgress) {
+			progress = append(progress, p)
+		},
+	})
+
+	if err := warmer.Warm(t.Context()); err != nil {
+		t.Fatal(err)
+	}
+
+	if requests.Load() != 3 {
+		t.Fatalf("expected 3 requests, got %d", requests.Load())
+	}
+
+	if len(progress) != 1 || progress[0].Done != 1 || progress[0].Total != 1 || progress[0].ID != 1 {
+		t.Fatalf("unexpected progress: %+v", progress)
+	}
+}
@@ -0,0 +1,228 @@
+package jikan
+
+import (
+	"cmp"
+	"fmt"
+	"slices"
+)
+
+// WatchOrderStrategy is how the anime of a franchise are ordered.
+type WatchOrderStrategy int
+
+const (
+	// WatchOrderRelease orders every anime by premiere date.
+	WatchOrderRelease WatchOrderStrategy = iota
+	// WatchOrderChronological follows prequel and sequel chains, placing side
+	// stories after their parent and summaries after the story they summarize.
+	// Anime without such relations are placed by premiere date.
+	WatchOrderChronological
+	// WatchOrderMainStory only includes the prequel and sequel chain of the root,
+	// excluding side stories, summaries and alternative versions.
+	WatchOrderMainStory
+)
+
+// WatchOrderEntry is an anime in a watch order with the reason it is placed there.
+type WatchOrderEntry struct {
+	ID     AnimeID
+	Anime  AnimeFull
+	Reason string
+}
+
+// orderConstraint places After after Before.
+type orderConstraint struct {
+	Before AnimeID
+	After  AnimeID
+	Reason string
+}
+
+// WatchOrder will order the anime of the graph. The order is stable, anime that
+// could be placed in either order are sorted by premiere date, then ID.
+func (g *FranchiseGraph) WatchOrder(strategy WatchOrderStrategy) []WatchOrderEntry {
+	switch strategy {
+	case WatchOrderChronological:
+		return g.orderByConstraints(g.nodeIDs(), g.constraints(nil))
+	case WatchOrderMainStory:
+		ids := g.mainStory()
+		return g.orderByConstraints(ids, g.constraints(ids))
+	}
+
+	ids := g.nodeIDs()
+	entries := make([]WatchOrderEntry, len(ids))
+	for i, id := range ids {
+		entries[i] = g.entry(id, g.releaseReason(id))
+	}
+
+	return entries
+}
+
+// nodeIDs will return every anime sorted by premiere date, then ID.
+func (g *FranchiseGraph) nodeIDs() []AnimeID {
+	ids := make([]AnimeID, 0, len(g.Nodes))
+	for id := range g.Nodes {
+		ids = append(ids, id)
+	}
+
+	slices.SortFunc(ids, g.compareRelease)
+	return ids
+}
+
+// compareRelease will order anime by premiere date, unknown dates last, then ID.
+func (g *FranchiseGraph) compareRelease(a, b AnimeID) int {
+	aStart, aOk := g.Nodes[a].Anime.StartDate()
+	bStart, bOk := g.Nodes[b].Anime.StartDate()
+
+	switch {
+	case aOk && bOk:
+		if c := aStart.Compare(bStart); c != 0 {
+			return c
+		}
+	case aOk:
+		return -1
+	case bOk:
+		return 1
+	}
+
+	return cmp.Compare(a, b)
+}
+
+func (g *FranchiseGraph) entry(id AnimeID, reason string) WatchOrderEntry {
+	return WatchOrderEntry{ID: id, Anime: g.Nodes[id].Anime, Reason: reason}
+}
+
+func (g *FranchiseGraph) label(id AnimeID) string {
+	if title := g.Nodes[id].Anime.PreferredTitle(TitleTypeEnglish, TitleTypeDefault); title != "" {
+		return title
+	}
+
+	return "anime " + id.String()
+}
+
+func (g *FranchiseGraph) releaseReason(id AnimeID) string {
+	start, ok := g.Nodes[id].Anime.StartDate()
+	if !ok {
+		return "premiere date unknown"
+	}
+
+	return "premiered " + start.String()
+}
+
+// constraints will turn story relations into ordering constraints. When ids is
+// set, only prequel and sequel relations between those anime are used.
+func (g *FranchiseGraph) constraints(ids []AnimeID) []orderConstraint {
+	var constraints []orderConstraint
+
+	for _, edge := range g.Edges {
+		if ids != nil {
+			if !slices.Contains(ids, edge.From) || !slices.Contains(ids, edge.To) {
+				continue
+			}
+
+			if edge.Kind != RelationSequel && edge.Kind != RelationPrequel {
+				continue
+			}
+		}
+
+		var c orderConstraint
+		switch edge.Kind {
+		case RelationSequel:
+			c = orderConstraint{Before: edge.From, After: edge.To, Reason: "sequel to %s"}
+		case RelationPrequel:
+			c = orderConstraint{Before: edge.To, After: edge.From, Reason: "sequel to %s"}
+		case RelationSideStory:
+			c = orderConstraint{Before: edge.From, After: edge.To, Reason: "side story of %s"}
+		case RelationParentStory:
+			c = orderConstraint{Before: edge.To, After: edge.From, Reason: "side story of %s"}
+		case RelationSummary:
+			c = orderConstraint{Before: edge.From, After: edge.To, Reason: "summary of %s"}
+		case RelationFullStory:
+			c = orderConstraint{Before: edge.To, After: edge.From, Reason: "summary of %s"}
+		default:
+			continue
+		}
+
+		if !slices.ContainsFunc(constraints, func(o orderConstraint) bool {
+			return o.Before == c.Before && o.After == c.After
+		}) {
+			constraints = append(constraints, c)
+		}
+	}
+
+	return constraints
+}
+
+// orderByConstraints will topologically sort ids, choosing the earliest released
+// anime whenever several are free. Cycles are broken the same way.
+func (g *FranchiseGraph) orderByConstraints(ids []AnimeID, constraints []orderConstraint) []WatchOrderEntry {
+	pending := slices.Clone(ids)
+	slices.SortFunc(pending, g.compareRelease)
+
+	placed := make(map[AnimeID]bool, len(ids))
+	entries := make([]WatchOrderEntry, 0, len(ids))
+
+	blocked := func(id AnimeID) bool {
+		return slices.ContainsFunc(constraints, func(c orderConstraint) bool {
+			return c.After == id && !placed[c.Before] && slices.Contains(pending, c.Before)
+		})
+	}
+
+	for len(pending) > 0 {
+		i := slices.IndexFunc(pending, func(id AnimeID) bool { return !blocked(id) })
+
+		cycle := i < 0
+		if cycle {
+			i = 0
+		}
+
+		id := pending[i]
+		pending = slices.Delete(pending, i, i+1)
+		placed[id] = true
+
+		reason := g.releaseReason(id)
+		for _, c := range constraints {
+			if c.After == id && placed[c.Before] && c.Before != id {
+				reason = fmt.Sprintf(c.Reason, g.label(c.Before))
+				break
+			}
+		}
+
+		if cycle {
+			reason += ", placed by premiere date to break a cycle"
+		}
+
+		entries = append(entries, g.entry(id, reason))
+	}
+
+	return entries
+}
+
+// mainStory will return the prequel and sequel chain of the root. When the root
+// is a side story or summary, the chain of the story it belongs to is used.
+func (g *FranchiseGraph) mainStory() []AnimeID {
+	start := g.Root
+	visited := map[AnimeID]bool{start: true}
+
+	for {
+		edges := g.EdgesFrom(start, RelationParentStory, RelationFullStory)
+		if len(edges) == 0 || visited[edges[0].To] {
+			break
+		}
+
+		start = edges[0].To
+		visited[start] = true
+	}
+
+	if _, ok := g.Nodes[start]; !ok {
+		return nil
+	}
+
+	ids := []AnimeID{start}
+	for i := 0; i < len(ids); i++ {
+		for _, edge := range g.EdgesFrom(ids[i], RelationSequel, RelationPrequel) {
+			if !slices.Contains(ids, edge.To) {
+				ids = append(ids, edge.To)
+			}
+		}
+	}
+
+	return ids
+}
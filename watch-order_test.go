@@ -0,0 +1,42 @@
+package jikan
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestWatchOrder(t *testing.T) {
+	client := newFranchiseClient(t)
+
+	graph, err := client.Anime.Franchise(t.Context(), 4, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		strategy WatchOrderStrategy
+		expected []AnimeID
+	}{
+		{WatchOrderRelease, []AnimeID{6, 1, 5, 2, 4, 3}},
+		{WatchOrderChronological, []AnimeID{6, 1, 5, 2, 4, 3}},
+		{WatchOrderMainStory, []AnimeID{1, 2, 3}},
+	}
+
+	for _, tt := range tests {
+		entries := graph.WatchOrder(tt.strategy)
+
+		ids := make([]AnimeID, len(entries))
+		for i, entry := range entries {
+			ids[i] = entry.ID
+		}
+
+		if !slices.Equal(ids, tt.expected) {
+			t.Fatalf("strategy %d: expected %v, got %v", tt.strategy, tt.expected, ids)
+		}
+	}
+
+	entries := graph.WatchOrder(WatchOrderChronological)
+	if entries[4].Reason != "side story of anime 2" {
+		t.Fatalf("unexpected reason: %q", entries[4].Reason)
+	}
+}
@@ -0,0 +1,353 @@
+package jikan
+
+import (
+	"context"
+	"errors"
+	"net/url"
+	"slices"
+	"sync"
+	"time"
+
+	"golang.org/x/time/rate"
+)
+
+const (
+	// defaultWatchInterval is the time between polls made by Watcher.Run.
+	defaultWatchInterval = time.Minute * 15
+	// defaultWatchRateShare leaves most of the rate limit to regular requests.
+	defaultWatchRateShare = 0.25
+	// defaultWatchBuffer is the capacity of the events channel.
+	defaultWatchBuffer = 64
+)
+
+var (
+	// ErrWatcherStarted is returned by Run when it was already called, a watcher
+	// can only be run once.
+	ErrWatcherStarted = errors.New("jikan: watcher already started")
+	// ErrWatcherClosed is returned by Poll once Run has returned and Events is closed.
+	ErrWatcherClosed = errors.New("jikan: watcher closed")
+)
+
+// WatchEventKind is the kind of change a Watcher noticed.
+type WatchEventKind string
+
+const (
+	// WatchEventNewEpisode is sent for an episode that was not listed before.
+	WatchEventNewEpisode WatchEventKind = "new-episode"
+	// WatchEventEpisodeAired is sent once the air date of an episode has passed.
+	WatchEventEpisodeAired WatchEventKind = "episode-aired"
+	// WatchEventStatusChanged is sent when the airing status changes.
+	WatchEventStatusChanged WatchEventKind = "status-changed"
+	// WatchEventScoreChanged is sent when the score changes.
+	WatchEventScoreChanged WatchEventKind = "score-changed"
+)
+
+// WatchEvent is a change to a watched anime.
+type WatchEvent struct {
+	Kind  WatchEventKind
+	ID    AnimeID
+	Anime Anime
+	// Episode is set for episode events.
+	Episode *Episode
+	// Change is set for status and score events, using DiffFieldStatus and DiffFieldScore.
+	Change FieldChange
+}
+
+// WatcherOptions configure which anime a Watcher polls and how often.
+type WatcherOptions struct {
+	// IDs are the anime to watch, more can be added with Watcher.Add.
+	IDs []AnimeID
+
+	// Interval is the time between polls made by Run. Defaults to 15 minutes.
+	Interval time.Duration
+	// RateShare is the share of the client's rate limit the watcher may use,
+	// between 0 and 1. Defaults to 0.25.
+	RateShare float64
+	// Buffer is the capacity of the events channel. Defaults to 64.
+	Buffer int
+
+	// OnError is called when polling an anime fails, it must be safe to call from
+	// the goroutine running the watcher.
+	OnError func(id AnimeID, err error)
+}
+
+// Watcher polls anime and their episodes, sending an event for every change.
+//
+// What was last seen is stored through Caches.Watch, so a restarted watcher only
+// reports changes made since its last poll. The first poll of an anime records
+// its state without sending events.
+type Watcher struct {
+	client  *Client
+	opts    WatcherOptions
+	limiter *rate.Limiter
+	events  chan WatchEvent
+	now     func() time.Time
+
+	mu      sync.Mutex
+	ids     []AnimeID
+	started bool
+
+	// stop is closed when Run returns, unblocking pending sends before Events
+	// is closed under sendMu.
+	stop   chan struct{}
+	sendMu sync.RWMutex
+	closed bool
+}
+
+// NewWatcher will create a watcher for the client. The watch state is stored in
+// the client's cache, so it returns an error when the cache is disabled.
+func (c *Client) NewWatcher(opts WatcherOptions) (*Watcher, error) {
+	if c.cache == nil {
+		return nil, errors.New("jikan: a watcher requires a cache")
+	}
+
+	if opts.Interval <= 0 {
+		opts.Interval = defaultWatchInterval
+	}
+
+	if opts.RateShare <= 0 || opts.RateShare > 1 {
+		opts.RateShare = defaultWatchRateShare
+	}
+
+	if opts.Buffer <= 0 {
+		opts.Buffer = defaultWatchBuffer
+	}
+
+	// Requests still go through the client's limiter, this only caps the watcher's share.
+	limit := c.limiter.Limit()
+	if limit != rate.Inf {
+		limit *= rate.Limit(opts.RateShare)
+	}
+
+	return &Watcher{
+		client:  c,
+		opts:    opts,
+		limiter: rate.NewLimiter(limit, max(int(float64(c.limiter.Burst())*opts.RateShare), 1)),
+		events:  make(chan WatchEvent, opts.Buffer),
+		stop:    make(chan struct{}),
+		now:     time.Now,
+		ids:     slices.Clone(opts.IDs),
+	}, nil
+}
+
+// Events will return the channel events are sent on, it is closed when Run returns.
+func (w *Watcher) Events() <-chan WatchEvent {
+	return w.events
+}
+
+// Add will start watching an anime from the next poll.
+func (w *Watcher) Add(id AnimeID) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	if !slices.Contains(w.ids, id) {
+		w.ids = append(w.ids, id)
+	}
+}
+
+// Remove will stop watching an anime, its stored state is kept.
+func (w *Watcher) Remove(id AnimeID) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	w.ids = slices.DeleteFunc(w.ids, func(watched AnimeID) bool { return watched == id })
+}
+
+// Run will poll every Interval until ctx is cancelled, then close Events. It
+// can only be called once, later calls return ErrWatcherStarted.
+//
+// Errors for single anime are reported through OnError and do not stop the watcher.
+func (w *Watcher) Run(ctx context.Context) error {
+	w.mu.Lock()
+	started := w.started
+	w.started = true
+	w.mu.Unlock()
+
+	if started {
+		return ErrWatcherStarted
+	}
+
+	defer w.close()
+
+	ticker := time.NewTicker(w.opts.Interval)
+	defer ticker.Stop()
+
+	for {
+		if err := w.Poll(ctx); err != nil && ctx.Err() != nil {
+			return ctx.Err()
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
+
+// close will close Events once no Poll is sending on it.
+func (w *Watcher) close() {
+	close(w.stop)
+
+	w.sendMu.Lock()
+	defer w.sendMu.Unlock()
+
+	w.closed = true
+	close(w.events)
+}
+
+// send will send event unless ctx is done or the watcher is closed.
+func (w *Watcher) send(ctx context.Context, event WatchEvent) error {
+	w.sendMu.RLock()
+	defer w.sendMu.RUnlock()
+
+	if w.closed {
+		return ErrWatcherClosed
+	}
+
+	select {
+	case w.events <- event:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-w.stop:
+		return ErrWatcherClosed
+	}
+}
+
+// Poll will check every watched anime once, sending events for what changed.
+// Sending blocks while Events is full. It returns ErrWatcherClosed once Run has
+// returned.
+func (w *Watcher) Poll(ctx context.Context) error {
+	w.sendMu.RLock()
+	closed := w.closed
+	w.sendMu.RUnlock()
+
+	if closed {
+		return ErrWatcherClosed
+	}
+
+	w.mu.Lock()
+	ids := slices.Clone(w.ids)
+	w.mu.Unlock()
+
+	var errs []error
+	for _, id := range ids {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+
+		if err := w.poll(ctx, id); err != nil {
+			if errors.Is(err, ErrWatcherClosed) {
+				return err
+			}
+
+			errs = append(errs, err)
+
+			if w.opts.OnError != nil {
+				w.opts.OnError(id, err)
+			}
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+// poll will fetch an anime and its episodes, bypassing the cache, and compare
+// them with the stored state.
+func (w *Watcher) poll(ctx context.Context, id AnimeID) error {
+	fetchCtx := ForceRefresh(ctx)
+
+	if err := w.limiter.Wait(ctx); err != nil {
+		return err
+	}
+
+	anime, _, err := w.client.Anime.GetByMalID(fetchCtx, id)
+	if err != nil {
+		return err
+	}
+
+	var episodes []Episode
+	for episode, err := range paginate(ctx, nil, 0, func(query *url.Values) (*PaginatedResponseBody[Episode], error) {
+		if err := w.limiter.Wait(ctx); err != nil {
+			return nil, err
+		}
+
+		info, _, err := w.client.Anime.GetEpisodesByMalID(fetchCtx, id, query)
+		return info, err
+	}) {
+		if err != nil {
+			return err
+		}
+
+		episodes = append(episodes, episode)
+	}
+
+	now := w.now()
+	state := WatchState{
+		ID:        id,
+		Status:    anime.Status,
+		Score:     anime.Score,
+		Episodes:  make(map[int]bool, len(episodes)),
+		CheckedAt: now,
+	}
+
+	for i := range episodes {
+		airedAt, ok := episodes[i].AiredAt()
+		state.Episodes[episodes[i].MalID] = ok && !airedAt.After(now)
+	}
+
+	previous, err := w.client.cache.Watch().GetWatchState(ctx, id)
+	if err != nil && !errors.Is(err, ErrCacheMiss) {
+		return err
+	}
+
+	if previous != nil {
+		for _, event := range watchEvents(previous, &state, anime, episodes) {
+			if err := w.send(ctx, event); err != nil {
+				return err
+			}
+		}
+	}
+
+	return w.client.cache.Watch().SetWatchState(ctx, state)
+}
+
+// watchEvents will return the events between two states, status and score changes first.
+func watchEvents(old, new *WatchState, anime *Anime, episodes []Episode) []WatchEvent {
+	var events []WatchEvent
+
+	if d := deref(old.Status); d != deref(new.Status) {
+		events = append(events, WatchEvent{
+			Kind:   WatchEventStatusChanged,
+			ID:     new.ID,
+			Anime:  *anime,
+			Change: FieldChange{Field: DiffFieldStatus, Old: d, New: deref(new.Status)},
+		})
+	}
+
+	if d := deref(old.Score); d != deref(new.Score) {
+		events = append(events, WatchEvent{
+			Kind:   WatchEventScoreChanged,
+			ID:     new.ID,
+			Anime:  *anime,
+			Change: FieldChange{Field: DiffFieldScore, Old: d, New: deref(new.Score)},
+		})
+	}
+
+	for i := range episodes {
+		episode := &episodes[i]
+
+		aired, known := old.Episodes[episode.MalID]
+		if !known {
+			events = append(events, WatchEvent{Kind: WatchEventNewEpisode, ID: new.ID, Anime: *anime, Episode: episode})
+		}
+
+		// A new episode that has already aired produces both events.
+		if !aired && new.Episodes[episode.MalID] {
+			events = append(events, WatchEvent{Kind: WatchEventEpisodeAired, ID: new.ID, Anime: *anime, Episode: episode})
+		}
+	}
+
+	return events
+}
@@ -0,0 +1,212 @@
+package jikan
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"slices"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestWatcherPoll(t *testing.T) {
+	airing, finished := AnimeStatusAiring, AnimeStatusFinished
+
+	var mu sync.Mutex
+	anime := Anime{MalID: 1, Status: &airing}
+	episodes := []Episode{{MalID: 1, Aired: new("2024-04-06T00:00:00+00:00")}}
+
+	client := newTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		defer mu.Unlock()
+
+		if strings.HasSuffix(r.URL.Path, "/episodes") {
+			_ = json.NewEncoder(w).Encode(PaginatedResponseBody[Episode]{Data: episodes})
+			return
+		}
+
+		_ = json.NewEncoder(w).Encode(ResponseBody[Anime]{Data: anime})
+	}))
+
+	watcher, err := client.NewWatcher(WatcherOptions{IDs: []AnimeID{1}, RateShare: 1})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	watcher.now = func() time.Time { return time.Date(2024, 4, 10, 0, 0, 0, 0, time.UTC) }
+
+	// The first poll only records the state.
+	if err := watcher.Poll(t.Context()); err != nil {
+		t.Fatal(err)
+	}
+
+	if len(watcher.Events()) != 0 {
+		t.Fatalf("expected no events after the first poll, got %d", len(watcher.Events()))
+	}
+
+	mu.Lock()
+	anime.Status = &finished
+	episodes = append(episodes, Episode{MalID: 2, Aired: new("2024-04-13T00:00:00+00:00")})
+	mu.Unlock()
+
+	if err := watcher.Poll(t.Context()); err != nil {
+		t.Fatal(err)
+	}
+
+	watcher.now = func() time.Time { return time.Date(2024, 4, 14, 0, 0, 0, 0, time.UTC) }
+	if err := watcher.Poll(t.Context()); err != nil {
+		t.Fatal(err)
+	}
+
+	var kinds []WatchEventKind
+	for len(watcher.Events()) > 0 {
+		event := <-watcher.Events()
+		kinds = append(kinds, event.Kind)
+
+		if event.Kind == WatchEventStatusChanged && (event.Change.Old != airing || event.Change.New != finished) {
+			t.Fatalf("unexpected status change: %+v", event.Change)
+		}
+
+		if event.Kind == WatchEventEpisodeAired && event.Episode.MalID != 2 {
+			t.Fatalf("unexpected aired episode: %d", event.Episode.MalID)
+		}
+	}
+
+	expected := []WatchEventKind{WatchEventStatusChanged, WatchEventNewEpisode, WatchEventEpisodeAired}
+	if !slices.Equal(kinds, expected) {
+		t.Fatalf("expected %v, got %v", expected, kinds)
+	}
+}
+
+func TestNewWatcherWithoutCache(t *testing.T) {
+	client := NewJikanClient(WithCache(nil))
+
+	if _, err := client.NewWatcher(WatcherOptions{}); err == nil {
+		t.Fatal("expected an error without a cache")
+	}
+}
+
+func TestWatchStateKeyspace(t *testing.T) {
+	if key := newCacheConfig([]CacheOption{WithKeyPrefix("staging")}).keys.watchState(1); key != "staging:watch:1" {
+		t.Fatalf("unexpected watch state key: %s", key)
+	}
+
+	cache := NewCache()
+	ctx := t.Context()
+
+	if err := cache.Watch().SetWatchState(ctx, WatchState{ID: 1}); err != nil {
+		t.Fatal(err)
+	}
+
+	for _, prefix := range []string{"anime:", ""} {
+		if err := cache.Purge(ctx, prefix); err != nil {
+			t.Fatal(err)
+		}
+
+		if _, err := cache.Watch().GetWatchState(ctx, 1); err != nil {
+			t.Fatalf("expected watch state to survive a purge of %q, got %v", prefix, err)
+		}
+	}
+
+	if err := cache.Purge(ctx, "watch:"); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := cache.Watch().GetWatchState(ctx, 1); !errors.Is(err, ErrCacheMiss) {
+		t.Fatalf("expected watch state to be purged, got %v", err)
+	}
+
+	if err := cache.Watch().SetWatchState(ctx, WatchState{ID: 1}); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := cache.FlushAll(ctx); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := cache.Watch().GetWatchState(ctx, 1); !errors.Is(err, ErrCacheMiss) {
+		t.Fatalf("expected watch state to be flushed, got %v", err)
+	}
+}
+
+func TestWatchStatePrefixAnchored(t *testing.T) {
+	states := newInMemoryCache[WatchState](JSONCodec)
+	short := newWatchStateCache(newCacheConfig([]CacheOption{WithKeyPrefix("jikan")}), states)
+	long := newWatchStateCache(newCacheConfig([]CacheOption{WithKeyPrefix("jikan:watch")}), states)
+	ctx := t.Context()
+
+	for _, cache := range []WatchStateCache{short, long} {
+		if err := cache.SetWatchState(ctx, WatchState{ID: 1}); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if err := short.Purge(ctx, "watch:"); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := short.GetWatchState(ctx, 1); !errors.Is(err, ErrCacheMiss) {
+		t.Fatalf("expected watch state to be purged, got %v", err)
+	}
+
+	if _, err := long.GetWatchState(ctx, 1); err != nil {
+		t.Fatalf("expected the longer prefix to keep its watch state, got %v", err)
+	}
+}
+
+func TestWatcherRunOnce(t *testing.T) {
+	client := newTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_ = json.NewEncoder(w).Encode(ResponseBody[Anime]{Data: Anime{MalID: 1}})
+	}))
+
+	watcher, err := client.NewWatcher(WatcherOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ctx, cancel := context.WithCancel(t.Context())
+	cancel()
+
+	if err := watcher.Run(ctx); !errors.Is(err, context.Canceled) {
+		t.Fatalf("expected context.Canceled, got %v", err)
+	}
+
+	if _, ok := <-watcher.Events(); ok {
+		t.Fatal("expected Events to be closed")
+	}
+
+	if err := watcher.Run(t.Context()); !errors.Is(err, ErrWatcherStarted) {
+		t.Fatalf("expected ErrWatcherStarted, got %v", err)
+	}
+
+	watcher.Add(1)
+	if err := watcher.Poll(t.Context()); !errors.Is(err, ErrWatcherClosed) {
+		t.Fatalf("expected ErrWatcherClosed, got %v", err)
+	}
+}
+
+func TestWatcherCloseWhileSending(t *testing.T) {
+	watcher, err := NewJikanClient().NewWatcher(WatcherOptions{Buffer: 1})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err := watcher.send(t.Context(), WatchEvent{}); err != nil {
+		t.Fatal(err)
+	}
+
+	// The buffer is full, so this send blocks until the watcher is closed.
+	sent := make(chan error)
+	go func() {
+		sent <- watcher.send(t.Context(), WatchEvent{})
+	}()
+
+	watcher.close()
+
+	if err := <-sent; !errors.Is(err, ErrWatcherClosed) {
+		t.Fatalf("expected ErrWatcherClosed, got %v", err)
+	}
+}